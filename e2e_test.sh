@@ -162,6 +162,8 @@ cd $REPO_ROOT/loom_test
 ./plasmacash_challenge_between_tester -hostile
 ./plasmacash_challenge_before_tester -hostile
 ./plasmacash_respond_challenge_before_tester -hostile
+./plasmacash_block_withholding_tester
+./plasmacash_invalid_tx_tester
 
 # Wait for Ganache & Loom to stop
 sleep 10
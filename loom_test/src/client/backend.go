@@ -0,0 +1,33 @@
+package client
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Backend is the connection to an Ethereum chain that's used to interact with the Plasma Cash
+// contracts. It's implemented by ethclient.Client, and can be implemented by a simulated backend
+// in unit tests.
+type Backend interface {
+	bind.ContractBackend
+	ethereum.TransactionReader
+
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	// HeaderByNumber returns the header of the given block, or of the latest block if number is nil.
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// DialBackend connects to the Ethereum node at the given URL.
+func DialBackend(url string) (Backend, error) {
+	conn, err := ethclient.Dial(url)
+	if err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
@@ -0,0 +1,224 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultBlockPollInterval = 1 * time.Second
+	DefaultBlockMaxBackoff   = 30 * time.Second
+)
+
+// BlockNotification reports a new Plasma block.
+type BlockNotification struct {
+	BlockNum *big.Int
+	// Root of the block submitted to the RootChain contract, only set if the notifier was
+	// configured to wait for block roots.
+	Root common.Hash
+}
+
+// BlockNotifierConfig configures the notifier created by Client.WatchBlocks.
+type BlockNotifierConfig struct {
+	// How often the DAppChain is polled for new blocks, defaults to DefaultBlockPollInterval.
+	PollInterval time.Duration
+	// The poll interval is doubled after each failed poll up to this limit, defaults to
+	// DefaultBlockMaxBackoff.
+	MaxBackoff time.Duration
+	// Last block the caller already knows about, notifications will start from the block that
+	// follows it. If nil notifications will start from the block that follows the current block.
+	StartBlock *big.Int
+	// If set each block will only be reported once it's been submitted to the RootChain contract,
+	// and the notification will include the root of the block.
+	WithRoots bool
+	// Called with the error each time a poll fails, the notifier keeps retrying regardless. Failed
+	// polls aren't reported anywhere if this isn't set.
+	OnPollError func(err error)
+}
+
+// blockNotifier polls for new Plasma blocks and reports each one in order.
+type blockNotifier struct {
+	cfg      BlockNotifierConfig
+	interval *big.Int
+	// Height of the last block that was reported.
+	lastBlock   *big.Int
+	blockNumber func(ctx context.Context) (*big.Int, error)
+	blockRoot   func(ctx context.Context, blockNum *big.Int) ([32]byte, error)
+	blocks      chan *BlockNotification
+}
+
+// WatchBlocks returns a channel that receives a notification for each new Plasma block. If several
+// blocks are created between polls every child & deposit block is still reported, in ascending
+// order. The channel is closed once the context is cancelled.
+func (c *Client) WatchBlocks(ctx context.Context, cfg *BlockNotifierConfig) (<-chan *BlockNotification, error) {
+	n := &blockNotifier{
+		interval:    big.NewInt(c.childBlockInterval),
+		blockNumber: c.GetBlockNumberContext,
+		blockRoot:   c.RootChain.BlockRoot,
+		blocks:      make(chan *BlockNotification),
+	}
+	if cfg != nil {
+		n.cfg = *cfg
+	}
+	if err := n.init(ctx); err != nil {
+		return nil, err
+	}
+	go n.run(ctx)
+	return n.blocks, nil
+}
+
+// WaitForBlockChange waits until a Plasma block newer than currentBlockNumber is created, and
+// returns the number of the new block.
+func (c *Client) WaitForBlockChange(ctx context.Context, currentBlockNumber *big.Int) (*big.Int, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	blocks, err := c.WatchBlocks(ctx, &BlockNotifierConfig{StartBlock: currentBlockNumber})
+	if err != nil {
+		return nil, err
+	}
+	block, ok := <-blocks
+	if !ok {
+		return nil, errors.Wrapf(ctx.Err(), "block didn't change from %v", currentBlockNumber)
+	}
+	return block.BlockNum, nil
+}
+
+// WaitForBlockChange waits until a Plasma block newer than currentBlockNumber is created, or the
+// timeout expires.
+func WaitForBlockChange(c *Client, currentBlockNumber *big.Int, timeout time.Duration) (*big.Int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return c.WaitForBlockChange(ctx, currentBlockNumber)
+}
+
+func (n *blockNotifier) init(ctx context.Context) error {
+	if n.cfg.PollInterval <= 0 {
+		n.cfg.PollInterval = DefaultBlockPollInterval
+	}
+	if n.cfg.MaxBackoff <= 0 {
+		n.cfg.MaxBackoff = DefaultBlockMaxBackoff
+	}
+	if n.cfg.MaxBackoff < n.cfg.PollInterval {
+		n.cfg.MaxBackoff = n.cfg.PollInterval
+	}
+	if n.cfg.StartBlock != nil {
+		n.lastBlock = new(big.Int).Set(n.cfg.StartBlock)
+		return nil
+	}
+	curBlock, err := n.blockNumber(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to retrieve current block number")
+	}
+	n.lastBlock = curBlock
+	return nil
+}
+
+func (n *blockNotifier) run(ctx context.Context) {
+	defer close(n.blocks)
+
+	delay := n.cfg.PollInterval
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		if err := n.poll(ctx); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			delay *= 2
+			if delay > n.cfg.MaxBackoff {
+				delay = n.cfg.MaxBackoff
+			}
+			if n.cfg.OnPollError != nil {
+				n.cfg.OnPollError(errors.Wrapf(err, "block notifier failed to poll, retrying in %v", delay))
+			}
+		} else {
+			delay = n.cfg.PollInterval
+		}
+		timer.Reset(delay)
+	}
+}
+
+// poll reports all the blocks created since the last poll.
+func (n *blockNotifier) poll(ctx context.Context) error {
+	latestBlock, err := n.blockNumber(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to retrieve current block number")
+	}
+	for latestBlock.Cmp(n.lastBlock) > 0 {
+		blockNum, root, err := n.nextBlock(ctx, latestBlock)
+		if err != nil {
+			return err
+		}
+		if blockNum == nil {
+			return nil
+		}
+		notification := &BlockNotification{BlockNum: blockNum}
+		if n.cfg.WithRoots {
+			// Wait for the block to be submitted to the RootChain before reporting it, or any
+			// of the blocks that follow it.
+			if root == nil {
+				if root, err = n.fetchRoot(ctx, blockNum); err != nil {
+					return err
+				}
+			}
+			if *root == (common.Hash{}) {
+				return nil
+			}
+			notification.Root = *root
+		}
+		select {
+		case n.blocks <- notification:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		n.lastBlock = blockNum
+	}
+	return nil
+}
+
+// nextBlock returns the number of the block that follows the last reported block, up to and
+// including the latest block, or nil if there's no such block yet. Deposit blocks are numbered
+// consecutively after each child block, but the DAppChain only reports the latest block, so the
+// RootChain is checked for a deposit block that directly follows the last reported block. The
+// root of the deposit block is returned along with its number, the root isn't fetched for child
+// blocks.
+func (n *blockNotifier) nextBlock(ctx context.Context, latestBlock *big.Int) (*big.Int, *common.Hash, error) {
+	nextChild := new(big.Int).Div(n.lastBlock, n.interval)
+	nextChild.Add(nextChild, big.NewInt(1)).Mul(nextChild, n.interval)
+
+	nextDeposit := new(big.Int).Add(n.lastBlock, big.NewInt(1))
+	if nextDeposit.Cmp(nextChild) < 0 && nextDeposit.Cmp(latestBlock) <= 0 {
+		root, err := n.fetchRoot(ctx, nextDeposit)
+		if err != nil {
+			return nil, nil, err
+		}
+		if *root != (common.Hash{}) {
+			return nextDeposit, root, nil
+		}
+	}
+	if nextChild.Cmp(latestBlock) <= 0 {
+		return nextChild, nil, nil
+	}
+	return nil, nil, nil
+}
+
+func (n *blockNotifier) fetchRoot(ctx context.Context, blockNum *big.Int) (*common.Hash, error) {
+	root, err := n.blockRoot(ctx, blockNum)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve root of block %v", blockNum)
+	}
+	hash := common.Hash(root)
+	return &hash, nil
+}
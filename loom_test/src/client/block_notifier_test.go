@@ -0,0 +1,152 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type BlockNotifierTestSuite struct{}
+
+var _ = Suite(&BlockNotifierTestSuite{})
+
+type testBlockSource struct {
+	mutex     sync.Mutex
+	blockNum  int64
+	failures  int
+	submitted int64
+	// Deposit blocks, these are on the RootChain as soon as they're created
+	deposits map[int64]bool
+}
+
+func (s *testBlockSource) setBlockNum(blockNum int64) {
+	s.mutex.Lock()
+	s.blockNum = blockNum
+	s.mutex.Unlock()
+}
+
+func (s *testBlockSource) addDeposits(blockNums ...int64) {
+	s.mutex.Lock()
+	if s.deposits == nil {
+		s.deposits = make(map[int64]bool)
+	}
+	for _, blockNum := range blockNums {
+		s.deposits[blockNum] = true
+	}
+	s.mutex.Unlock()
+}
+
+func (s *testBlockSource) blockNumber(ctx context.Context) (*big.Int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.failures > 0 {
+		s.failures--
+		return nil, errors.New("connection refused")
+	}
+	return big.NewInt(s.blockNum), nil
+}
+
+func (s *testBlockSource) blockRoot(ctx context.Context, blockNum *big.Int) ([32]byte, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var root [32]byte
+	if blockNum.Int64()%1000 != 0 {
+		if s.deposits[blockNum.Int64()] {
+			root[1] = byte(blockNum.Int64() % 1000)
+		}
+	} else if blockNum.Int64() <= s.submitted {
+		root[0] = byte(blockNum.Int64() / 1000)
+	}
+	return root, nil
+}
+
+func newTestBlockNotifier(source *testBlockSource, cfg BlockNotifierConfig) *blockNotifier {
+	cfg.PollInterval = time.Millisecond
+	return &blockNotifier{
+		cfg:         cfg,
+		interval:    big.NewInt(1000),
+		blockNumber: source.blockNumber,
+		blockRoot:   source.blockRoot,
+		blocks:      make(chan *BlockNotification),
+	}
+}
+
+func receiveBlocks(c *C, blocks <-chan *BlockNotification, count int) []int64 {
+	var received []int64
+	for i := 0; i < count; i++ {
+		select {
+		case block := <-blocks:
+			received = append(received, block.BlockNum.Int64())
+		case <-time.After(5 * time.Second):
+			c.Fatalf("timed out waiting for block notification, received %v", received)
+		}
+	}
+	return received
+}
+
+func (s *BlockNotifierTestSuite) TestNoMissedBlocks(c *C) {
+	source := &testBlockSource{blockNum: 1000}
+	n := newTestBlockNotifier(source, BlockNotifierConfig{})
+	ctx, cancel := context.WithCancel(context.Background())
+	c.Assert(n.init(ctx), IsNil)
+	go n.run(ctx)
+
+	// Several child & deposit blocks land between polls
+	source.addDeposits(2001, 4001, 4002)
+	source.setBlockNum(4002)
+	c.Assert(receiveBlocks(c, n.blocks, 6), DeepEquals, []int64{2000, 2001, 3000, 4000, 4001, 4002})
+
+	source.setBlockNum(5000)
+	c.Assert(receiveBlocks(c, n.blocks, 1), DeepEquals, []int64{5000})
+
+	// The channel should be closed once the context is cancelled
+	cancel()
+	for range n.blocks {
+	}
+}
+
+func (s *BlockNotifierTestSuite) TestRetryAfterFailedPoll(c *C) {
+	source := &testBlockSource{blockNum: 2000, failures: 3}
+	var pollErrs []error
+	n := newTestBlockNotifier(source, BlockNotifierConfig{
+		StartBlock:  big.NewInt(1000),
+		OnPollError: func(err error) { pollErrs = append(pollErrs, err) },
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c.Assert(n.init(ctx), IsNil)
+	go n.run(ctx)
+
+	c.Assert(receiveBlocks(c, n.blocks, 1), DeepEquals, []int64{2000})
+	c.Assert(pollErrs, HasLen, 3)
+}
+
+func (s *BlockNotifierTestSuite) TestWaitForRoots(c *C) {
+	source := &testBlockSource{blockNum: 3000, submitted: 2000}
+	n := newTestBlockNotifier(source, BlockNotifierConfig{StartBlock: big.NewInt(0), WithRoots: true})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c.Assert(n.init(ctx), IsNil)
+	go n.run(ctx)
+
+	block := <-n.blocks
+	c.Assert(block.BlockNum.Int64(), Equals, int64(1000))
+	c.Assert(block.Root[0], Equals, byte(1))
+	block = <-n.blocks
+	c.Assert(block.BlockNum.Int64(), Equals, int64(2000))
+
+	// Block 3000 shouldn't be reported until it's been submitted to the RootChain
+	select {
+	case block = <-n.blocks:
+		c.Fatalf("block %v reported before it was submitted", block.BlockNum)
+	case <-time.After(20 * time.Millisecond):
+	}
+	source.mutex.Lock()
+	source.submitted = 3000
+	source.mutex.Unlock()
+	c.Assert(receiveBlocks(c, n.blocks, 1), DeepEquals, []int64{3000})
+}
@@ -0,0 +1,126 @@
+package client
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// How often the challenge watchers query the RootChain contract for new ChallengedExit events.
+const ChallengeWatcherPollInterval = 2 * time.Second
+
+// ChallengeEvent is emitted by a challenge watcher whenever an exit of the slot it's watching is
+// challenged with challengeBefore.
+type ChallengeEvent struct {
+	Slot uint64
+	// Hash of the Plasma tx used to challenge the exit
+	TxHash                 [32]byte
+	ChallengingBlockNumber *big.Int
+	// Owner & exit block of the challenged exit, at the time the event was processed
+	ExitOwner common.Address
+	ExitBlock *big.Int
+	// Ethereum block & tx in which the challenge was submitted
+	EthBlockNumber uint64
+	EthTxHash      common.Hash
+
+	// Plasma block containing the tx that was used to respond to the challenge, will be nil if the
+	// watcher didn't respond to the challenge.
+	RespondingBlockNumber *big.Int
+	// Hash of the Ethereum tx that submitted the response
+	ResponseTxHash []byte
+	// Set if the watcher failed to respond to the challenge
+	ResponseErr error
+}
+
+// challengeWatcher polls the RootChain contract for challenges of the exits of a single slot, and
+// automatically responds to any challenges of the client's own exits.
+type challengeWatcher struct {
+	*eventPoller
+	client *Client
+	slot   uint64
+	events chan *ChallengeEvent
+}
+
+// newChallengeWatcher creates a watcher that will respond to all the challenges of the exits of
+// the given slot that are submitted from the current Ethereum block onwards.
+func newChallengeWatcher(c *Client, slot uint64, pollInterval time.Duration) (*challengeWatcher, error) {
+	w := &challengeWatcher{
+		client: c,
+		slot:   slot,
+		events: make(chan *ChallengeEvent, 16),
+	}
+	poller, err := newEventPoller(fmt.Sprintf("challenge watcher (slot %d)", slot), c.RootChain, pollInterval, w.poll)
+	if err != nil {
+		return nil, err
+	}
+	w.eventPoller = poller
+	return w, nil
+}
+
+func (w *challengeWatcher) run() {
+	defer close(w.events)
+	w.eventPoller.run()
+}
+
+func (w *challengeWatcher) poll(startBlock, endBlock uint64) error {
+	challenges, err := w.rootChain.ChallengedExits(w.ctx, w.slot, startBlock, endBlock)
+	if err != nil {
+		return err
+	}
+	for _, challenge := range challenges {
+		w.respond(challenge)
+		select {
+		case w.events <- challenge:
+		case <-w.stopped():
+			return nil
+		}
+	}
+	return nil
+}
+
+// respond responds to the given challenge if it was submitted against an exit by the client.
+func (w *challengeWatcher) respond(challenge *ChallengeEvent) {
+	account, err := w.client.TokenContract.Account()
+	if err != nil {
+		challenge.ResponseErr = err
+		return
+	}
+	if challenge.ExitOwner != common.HexToAddress(account.Address) {
+		return
+	}
+
+	history, err := w.client.CoinHistoryContext(w.ctx, challenge.Slot)
+	if err != nil {
+		challenge.ResponseErr = err
+		return
+	}
+	respondingTx := findChallengeResponse(challenge, history)
+	if respondingTx == nil {
+		challenge.ResponseErr = fmt.Errorf(
+			"no spend of the challenging tx from block %v found in the history of slot %d",
+			challenge.ChallengingBlockNumber, challenge.Slot,
+		)
+		return
+	}
+	challenge.RespondingBlockNumber = respondingTx.BlockNum
+	challenge.ResponseTxHash, challenge.ResponseErr = w.client.RespondChallengeBeforeContext(
+		w.ctx, challenge.Slot, respondingTx.BlockNum, challenge.TxHash,
+	)
+}
+
+// findChallengeResponse looks for the tx in the history of a coin that directly spends the tx
+// used to challenge an exit, and isn't newer than the exit itself.
+func findChallengeResponse(challenge *ChallengeEvent, history []*CoinTx) *CoinTx {
+	for _, tx := range history {
+		if tx.IsDeposit() || tx.PrevBlock.Cmp(challenge.ChallengingBlockNumber) != 0 {
+			continue
+		}
+		if challenge.ExitBlock != nil && tx.BlockNum.Cmp(challenge.ExitBlock) > 0 {
+			continue
+		}
+		return tx
+	}
+	return nil
+}
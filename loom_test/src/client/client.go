@@ -1,11 +1,12 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"math/big"
 	"strings"
-	"time"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -15,43 +16,20 @@ import (
 	"github.com/loomnetwork/go-loom/client/plasma_cash/eth"
 )
 
-func PollForBlockChange(c *Client, currentBlockNumber *big.Int, maxIteration int, sleepPerIteration time.Duration) (*big.Int, error) {
-	currentIteration := 0
-	var err error
-	var updatedBlockNumber = big.NewInt(0)
-	for {
-		time.Sleep(sleepPerIteration)
-
-		fmt.Printf("Polling, CurrentBlockNumber: %s\n", currentBlockNumber.String())
-
-		updatedBlockNumber, err = c.GetBlockNumber()
-		if err != nil {
-			err = fmt.Errorf("Error while polling for current block number")
-			break
-		}
-
-		if updatedBlockNumber.Cmp(currentBlockNumber) != 0 {
-			fmt.Printf("Updated BlockNumber to: %s\n", updatedBlockNumber.String())
-			break
-		}
-
-		currentIteration += 1
-		if currentIteration >= maxIteration {
-			err = fmt.Errorf("Maximum iteration exceeded but, block didnt change")
-			break
-		}
-	}
-
-	return updatedBlockNumber, err
-}
-
 type Client struct {
 	childChain         plasma_cash.ChainServiceClient
-	RootChain          plasma_cash.RootChainClient
-	TokenContract      plasma_cash.TokenContract
+	RootChain          RootChainClient
+	TokenContract      TokenContract
 	childBlockInterval int64
-	blocks             map[string]plasma_cash.Block
+	store              CoinStore
+	userSlots          UserSlotsReader
 	plasmaEthClient    eth.EthPlasmaClient
+
+	exitWatchersMutex sync.Mutex
+	exitWatchers      map[uint64]*exitWatcher
+
+	challengeWatchersMutex sync.Mutex
+	challengeWatchers      map[uint64]*challengeWatcher
 }
 
 const ChildBlockInterval = 1000
@@ -60,18 +38,26 @@ const ChildBlockInterval = 1000
 
 // Register a new player and grant 5 cards, for demo purposes
 func (c *Client) Register() {
-	c.TokenContract.Register()
+	c.RegisterContext(context.Background())
+}
+
+func (c *Client) RegisterContext(ctx context.Context) error {
+	return c.TokenContract.RegisterContext(ctx)
 }
 
 // Deposit happens by a use calling the erc721 token contract
 func (c *Client) Deposit(tokenID *big.Int) common.Hash {
-	txHash, err := c.TokenContract.Deposit(tokenID)
+	txHash, err := c.DepositContext(context.Background(), tokenID)
 	if err != nil {
 		panic(err)
 	}
 	return txHash
 }
 
+func (c *Client) DepositContext(ctx context.Context, tokenID *big.Int) (common.Hash, error) {
+	return c.TokenContract.DepositContext(ctx, tokenID)
+}
+
 // Plasma Functions
 
 func Transaction(slot uint64, prevTxBlkNum *big.Int, denomination *big.Int, address string) plasma_cash.Tx {
@@ -84,14 +70,16 @@ func Transaction(slot uint64, prevTxBlkNum *big.Int, denomination *big.Int, addr
 }
 
 func (c *Client) StartExit(slot uint64, prevTxBlkNum *big.Int, txBlkNum *big.Int) ([]byte, error) {
+	return c.StartExitContext(context.Background(), slot, prevTxBlkNum, txBlkNum)
+}
+
+func (c *Client) StartExitContext(ctx context.Context, slot uint64, prevTxBlkNum *big.Int, txBlkNum *big.Int) ([]byte, error) {
 	// As a user, you declare that you want to exit a coin at slot `slot`
 	//at the state which happened at block `txBlkNum` and you also need to
 	// reference a previous block
 
-	// TODO The actual proof information should be passed to a user from its
-	// previous owners, this is a hacky way of getting the info from the
-	// operator which sould be changed in the future after the exiting
-	// process is more standardized
+	// The txs & proofs are looked up in the local coin store, and only
+	// fetched from the operator if the client hasn't stored them already.
 	account, err := c.TokenContract.Account()
 	if err != nil {
 		return nil, err
@@ -112,8 +100,8 @@ func (c *Client) StartExit(slot uint64, prevTxBlkNum *big.Int, txBlkNum *big.Int
 			return nil, err
 		}
 
-		txHash, err := c.RootChain.StartExit(
-			slot,
+		txHash, err := c.RootChain.StartExitContext(
+			ctx, slot,
 			nil, exitingTx,
 			nil, nil, //proofs?
 			exitingTxSig,
@@ -126,19 +114,19 @@ func (c *Client) StartExit(slot uint64, prevTxBlkNum *big.Int, txBlkNum *big.Int
 
 	// Otherwise, they should get the raw tx info from the block
 	// And the merkle proof and submit these
-	exitingTx, exitingTxProof, err := c.getTxAndProof(txBlkNum, slot)
+	exitingTx, exitingTxProof, err := c.getTxAndProof(ctx, txBlkNum, slot)
 	if err != nil {
 		return nil, err
 	}
 
-	prevTx, prevTxProof, err := c.getTxAndProof(prevTxBlkNum, slot)
+	prevTx, prevTxProof, err := c.getTxAndProof(ctx, prevTxBlkNum, slot)
 	if err != nil {
 		return nil, err
 	}
 	sig := exitingTx.Sig()
 
-	return c.RootChain.StartExit(
-		slot,
+	return c.RootChain.StartExitContext(
+		ctx, slot,
 		prevTx, exitingTx,
 		prevTxProof, exitingTxProof,
 		sig,
@@ -146,6 +134,10 @@ func (c *Client) StartExit(slot uint64, prevTxBlkNum *big.Int, txBlkNum *big.Int
 }
 
 func (c *Client) ChallengeBefore(slot uint64, txBlkNum *big.Int) ([]byte, error) {
+	return c.ChallengeBeforeContext(context.Background(), slot, txBlkNum)
+}
+
+func (c *Client) ChallengeBeforeContext(ctx context.Context, slot uint64, txBlkNum *big.Int) ([]byte, error) {
 	account, err := c.TokenContract.Account()
 	if err != nil {
 		return nil, err
@@ -162,8 +154,8 @@ func (c *Client) ChallengeBefore(slot uint64, txBlkNum *big.Int) ([]byte, error)
 			return nil, err
 		}
 
-		txHash, err := c.RootChain.ChallengeBefore(
-			slot,
+		txHash, err := c.RootChain.ChallengeBeforeContext(
+			ctx, slot,
 			exitingTx,
 			nil,
 			exitingTxSig,
@@ -176,7 +168,7 @@ func (c *Client) ChallengeBefore(slot uint64, txBlkNum *big.Int) ([]byte, error)
 
 	// Otherwise, they should get the raw tx info from the block
 	// And the merkle proof and submit these
-	exitingTx, exitingTxProof, err := c.getTxAndProof(txBlkNum, slot)
+	exitingTx, exitingTxProof, err := c.getTxAndProof(ctx, txBlkNum, slot)
 	if err != nil {
 		return nil, err
 	}
@@ -186,8 +178,8 @@ func (c *Client) ChallengeBefore(slot uint64, txBlkNum *big.Int) ([]byte, error)
 		return nil, err
 	}
 
-	txHash, err := c.RootChain.ChallengeBefore(
-		slot,
+	txHash, err := c.RootChain.ChallengeBeforeContext(
+		ctx, slot,
 		exitingTx,
 		exitingTxProof,
 		exitingTxSig,
@@ -199,12 +191,17 @@ func (c *Client) ChallengeBefore(slot uint64, txBlkNum *big.Int) ([]byte, error)
 // RespondChallengeBefore - Respond to an exit with invalid history challenge by proving that
 // you were given the coin under question
 func (c *Client) RespondChallengeBefore(slot uint64, respondingBlockNumber *big.Int, challengingTxHash [32]byte) ([]byte, error) {
-	respondingTx, proof, err := c.getTxAndProof(respondingBlockNumber, slot)
+	return c.RespondChallengeBeforeContext(context.Background(), slot, respondingBlockNumber, challengingTxHash)
+}
+
+func (c *Client) RespondChallengeBeforeContext(ctx context.Context, slot uint64, respondingBlockNumber *big.Int,
+	challengingTxHash [32]byte) ([]byte, error) {
+	respondingTx, proof, err := c.getTxAndProof(ctx, respondingBlockNumber, slot)
 	if err != nil {
 		return nil, err
 	}
 
-	txHash, err := c.RootChain.RespondChallengeBefore(slot,
+	txHash, err := c.RootChain.RespondChallengeBeforeContext(ctx, slot,
 		challengingTxHash,
 		respondingBlockNumber,
 		respondingTx,
@@ -216,13 +213,17 @@ func (c *Client) RespondChallengeBefore(slot uint64, respondingBlockNumber *big.
 // ChallengeBetween - `Double Spend Challenge`: Challenge a double spend of a coin
 // with a spend between the exit's blocks
 func (c *Client) ChallengeBetween(slot uint64, challengingBlockNumber *big.Int) ([]byte, error) {
-	challengingTx, proof, err := c.getTxAndProof(challengingBlockNumber, slot)
+	return c.ChallengeBetweenContext(context.Background(), slot, challengingBlockNumber)
+}
+
+func (c *Client) ChallengeBetweenContext(ctx context.Context, slot uint64, challengingBlockNumber *big.Int) ([]byte, error) {
+	challengingTx, proof, err := c.getTxAndProof(ctx, challengingBlockNumber, slot)
 	if err != nil {
 		return nil, err
 	}
 
-	txHash, err := c.RootChain.ChallengeBetween(
-		slot,
+	txHash, err := c.RootChain.ChallengeBetweenContext(
+		ctx, slot,
 		challengingBlockNumber,
 		challengingTx,
 		proof,
@@ -234,15 +235,19 @@ func (c *Client) ChallengeBetween(slot uint64, challengingBlockNumber *big.Int)
 // ChallengeAfter - `Exit Spent Coin Challenge`: Challenge an exit with a spend
 // after the exit's blocks
 func (c *Client) ChallengeAfter(slot uint64, challengingBlockNumber *big.Int) ([]byte, error) { //
+	return c.ChallengeAfterContext(context.Background(), slot, challengingBlockNumber)
+}
+
+func (c *Client) ChallengeAfterContext(ctx context.Context, slot uint64, challengingBlockNumber *big.Int) ([]byte, error) {
 	fmt.Printf("Challenege after getting block-%d - slot %d\n", challengingBlockNumber, slot)
-	challengingTx, proof, err := c.getTxAndProof(challengingBlockNumber,
+	challengingTx, proof, err := c.getTxAndProof(ctx, challengingBlockNumber,
 		slot)
 	if err != nil {
 		return nil, err
 	}
 
-	txHash, err := c.RootChain.ChallengeAfter(
-		slot, challengingBlockNumber,
+	txHash, err := c.RootChain.ChallengeAfterContext(
+		ctx, slot, challengingBlockNumber,
 		challengingTx,
 		proof,
 		challengingTx.Sig())
@@ -250,31 +255,65 @@ func (c *Client) ChallengeAfter(slot uint64, challengingBlockNumber *big.Int) ([
 }
 
 func (c *Client) CancelExit(slot uint64) error {
-	return c.RootChain.CancelExit(slot)
+	return c.CancelExitContext(context.Background(), slot)
+}
+
+func (c *Client) CancelExitContext(ctx context.Context, slot uint64) error {
+	_, err := c.RootChain.CancelExitTx(ctx, slot)
+	return err
 }
 
 func (c *Client) CancelExits(slots []uint64) error {
-	return c.RootChain.CancelExits(slots)
+	return c.CancelExitsContext(context.Background(), slots)
+}
+
+func (c *Client) CancelExitsContext(ctx context.Context, slots []uint64) error {
+	_, err := c.RootChain.CancelExitsTx(ctx, slots)
+	return err
 }
 
 func (c *Client) FinalizeExit(slot uint64) error {
-	return c.RootChain.FinalizeExit(slot)
+	return c.FinalizeExitContext(context.Background(), slot)
+}
+
+func (c *Client) FinalizeExitContext(ctx context.Context, slot uint64) error {
+	_, err := c.RootChain.FinalizeExitTx(ctx, slot)
+	return err
 }
 
 func (c *Client) FinalizeExits(slots []uint64) error {
-	return c.RootChain.FinalizeExits(slots)
+	return c.FinalizeExitsContext(context.Background(), slots)
+}
+
+func (c *Client) FinalizeExitsContext(ctx context.Context, slots []uint64) error {
+	_, err := c.RootChain.FinalizeExitsTx(ctx, slots)
+	return err
 }
 
 func (c *Client) Withdraw(slot uint64) error {
-	return c.RootChain.Withdraw(slot)
+	return c.WithdrawContext(context.Background(), slot)
+}
+
+func (c *Client) WithdrawContext(ctx context.Context, slot uint64) error {
+	_, err := c.RootChain.WithdrawTx(ctx, slot)
+	return err
 }
 
 func (c *Client) WithdrawBonds() error {
-	return c.RootChain.WithdrawBonds()
+	return c.WithdrawBondsContext(context.Background())
+}
+
+func (c *Client) WithdrawBondsContext(ctx context.Context) error {
+	_, err := c.RootChain.WithdrawBondsTx(ctx)
+	return err
 }
 
 func (c *Client) PlasmaCoin(slot uint64) (*plasma_cash.PlasmaCoin, error) {
-	return c.RootChain.PlasmaCoin(slot)
+	return c.PlasmaCoinContext(context.Background(), slot)
+}
+
+func (c *Client) PlasmaCoinContext(ctx context.Context, slot uint64) (*plasma_cash.PlasmaCoin, error) {
+	return c.RootChain.PlasmaCoinContext(ctx, slot)
 }
 
 func (c *Client) DebugCoinMetaData(slots []uint64) {
@@ -284,6 +323,13 @@ func (c *Client) DebugCoinMetaData(slots []uint64) {
 // Child Chain Functions
 
 func (c *Client) SendTransaction(slot uint64, prevBlock *big.Int, denomination *big.Int, newOwner string) error {
+	return c.SendTransactionContext(context.Background(), slot, prevBlock, denomination, newOwner)
+}
+
+// SendTransactionContext transfers a coin to a new owner. The DAppChain client doesn't support
+// contexts, so the transfer can only be cancelled before it's sent to the DAppChain.
+func (c *Client) SendTransactionContext(ctx context.Context, slot uint64, prevBlock *big.Int, denomination *big.Int,
+	newOwner string) error {
 	ethAddress := common.HexToAddress(newOwner)
 
 	tx := &plasma_cash.LoomTx{
@@ -303,51 +349,214 @@ func (c *Client) SendTransaction(slot uint64, prevBlock *big.Int, denomination *
 		return err
 	}
 
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 	return c.childChain.SendTransaction(slot, prevBlock, denomination, newOwner, account.Address, sig)
 }
 
-func (c *Client) getTxAndProof(blkHeight *big.Int, slot uint64) (plasma_cash.Tx, []byte, error) {
-	tx, err := c.childChain.PlasmaTx(blkHeight, slot)
+// getTxAndProof returns the tx of the coin at the given slot that was included in the given block,
+// along with its inclusion proof. The tx is looked up in the local coin store first, and is only
+// fetched from the operator if it hasn't been stored yet, txs fetched from the operator are
+// stored so they remain available even if the operator stops serving them.
+func (c *Client) getTxAndProof(ctx context.Context, blkHeight *big.Int, slot uint64) (plasma_cash.Tx, []byte, error) {
+	if err := c.syncCoinBlock(ctx, slot, blkHeight); err != nil {
+		return nil, nil, err
+	}
+	coinTx, err := c.store.Tx(slot, blkHeight)
 	if err != nil {
 		return nil, nil, err
 	}
+	tx := coinTx.LoomTx()
 	return tx, tx.Proof(), nil
 }
 
-func (c *Client) WatchExits(slot uint64) error {
-	panic("TODO")
+// syncCoinBlock makes sure the local coin store contains either the tx of the coin at the given slot
+// that was included in the given block, or a proof that the coin wasn't transferred in the block.
+// Data fetched from the operator is verified against the block root submitted to the RootChain
+// before it's stored, so nothing is stored for blocks that haven't been submitted yet.
+func (c *Client) syncCoinBlock(ctx context.Context, slot uint64, blkHeight *big.Int) error {
+	if _, err := c.store.Tx(slot, blkHeight); err != ErrCoinTxNotFound {
+		return err
+	}
+	if _, err := c.store.Exclusion(slot, blkHeight); err != ErrCoinTxNotFound {
+		return err
+	}
+
+	root, err := c.blockRoot(ctx, blkHeight)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	tx, err := c.childChain.PlasmaTx(blkHeight, slot)
+	if err != nil {
+		return err
+	}
+	coinTx, err := newCoinTx(blkHeight, tx)
+	if err != nil {
+		return err
+	}
+	// The operator returns an empty tx, along with an exclusion proof, if the coin wasn't
+	// transferred in the block.
+	if coinTx.Owner == (common.Address{}) {
+		exclusion := &CoinExclusion{
+			BlockNum: coinTx.BlockNum,
+			Slot:     slot,
+			Proof:    coinTx.Proof,
+		}
+		if err := c.verifyExclusion(root, exclusion); err != nil {
+			return err
+		}
+		return c.store.PutExclusion(exclusion)
+	}
+	if coinTx.Slot != slot {
+		return fmt.Errorf("operator returned tx for slot %d instead of slot %d", coinTx.Slot, slot)
+	}
+	if err := c.verifyInclusion(root, coinTx); err != nil {
+		return err
+	}
+	return c.store.PutTx(coinTx)
+}
+
+// CoinStore returns the store the client uses to keep track of the history of its coins.
+func (c *Client) CoinStore() CoinStore {
+	return c.store
 }
 
+// SetCoinStore replaces the store the client uses to keep track of the history of its coins, by
+// default the history is only stored in memory.
+func (c *Client) SetCoinStore(store CoinStore) {
+	c.store = store
+}
+
+// WatchExits starts watching for exits of the coin at the given slot, any exits started from now on
+// will be sent to the returned channel. The channel will be closed when StopWatchingExits is called
+// for the same slot.
+func (c *Client) WatchExits(slot uint64) (<-chan *ExitEvent, error) {
+	c.exitWatchersMutex.Lock()
+	defer c.exitWatchersMutex.Unlock()
+
+	if _, exists := c.exitWatchers[slot]; exists {
+		return nil, fmt.Errorf("already watching exits of slot %d", slot)
+	}
+	w, err := newExitWatcher(c.RootChain, slot, ExitWatcherPollInterval)
+	if err != nil {
+		return nil, err
+	}
+	c.exitWatchers[slot] = w
+	go w.run()
+	return w.events, nil
+}
+
+// StopWatchingExits stops watching for exits of the coin at the given slot.
 func (c *Client) StopWatchingExits(slot uint64) error {
-	panic("TODO")
+	c.exitWatchersMutex.Lock()
+	w, exists := c.exitWatchers[slot]
+	delete(c.exitWatchers, slot)
+	c.exitWatchersMutex.Unlock()
+
+	if !exists {
+		return fmt.Errorf("not watching exits of slot %d", slot)
+	}
+	w.stop()
+	return nil
+}
+
+// WatchChallenges starts watching for challengeBefore challenges of the exits of the coin at the
+// given slot. Any challenges of exits started by this client will be responded to automatically.
+// All the challenges submitted from now on will be sent to the returned channel, along with the
+// outcome of the response. The channel will be closed when StopWatchingChallenges is called for
+// the same slot.
+func (c *Client) WatchChallenges(slot uint64) (<-chan *ChallengeEvent, error) {
+	c.challengeWatchersMutex.Lock()
+	defer c.challengeWatchersMutex.Unlock()
+
+	if _, exists := c.challengeWatchers[slot]; exists {
+		return nil, fmt.Errorf("already watching challenges of slot %d", slot)
+	}
+	w, err := newChallengeWatcher(c, slot, ChallengeWatcherPollInterval)
+	if err != nil {
+		return nil, err
+	}
+	c.challengeWatchers[slot] = w
+	go w.run()
+	return w.events, nil
+}
+
+// StopWatchingChallenges stops watching for challenges of the exits of the coin at the given slot.
+func (c *Client) StopWatchingChallenges(slot uint64) error {
+	c.challengeWatchersMutex.Lock()
+	w, exists := c.challengeWatchers[slot]
+	delete(c.challengeWatchers, slot)
+	c.challengeWatchersMutex.Unlock()
+
+	if !exists {
+		return fmt.Errorf("not watching challenges of slot %d", slot)
+	}
+	w.stop()
+	return nil
 }
 
 func (c *Client) GetBlockNumber() (*big.Int, error) {
+	return c.GetBlockNumberContext(context.Background())
+}
+
+// GetBlockNumberContext returns the current Plasma block number. The DAppChain client doesn't
+// support contexts, so the query can only be cancelled before it's sent to the DAppChain.
+func (c *Client) GetBlockNumberContext(ctx context.Context) (*big.Int, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	return c.childChain.BlockNumber()
 }
 
 func (c *Client) GetBlock(blkHeight *big.Int) (plasma_cash.Block, error) {
+	return c.GetBlockContext(context.Background(), blkHeight)
+}
+
+// GetBlockContext returns the given Plasma block. The DAppChain client doesn't support contexts,
+// so the query can only be cancelled before it's sent to the DAppChain.
+func (c *Client) GetBlockContext(ctx context.Context, blkHeight *big.Int) (plasma_cash.Block, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	return c.childChain.Block(blkHeight)
 }
 
-func NewClient(cfg *viper.Viper, childChainServer plasma_cash.ChainServiceClient, rootChain plasma_cash.RootChainClient, tokenContract plasma_cash.TokenContract) *Client {
+func NewClient(cfg *viper.Viper, childChainServer plasma_cash.ChainServiceClient, rootChain RootChainClient, tokenContract TokenContract) *Client {
 	ethPrivKeyHexStr := cfg.GetString("authority")
 	ethPrivKey, err := crypto.HexToECDSA(strings.TrimPrefix(ethPrivKeyHexStr, "0x"))
 	if err != nil {
 		log.Fatalf("failed to load private key: %v", err)
 	}
-	ethCfg := eth.EthPlasmaClientConfig{
-		EthereumURI:      "http://localhost:8545",
+	ethCfg, err := LoadEthConfig(cfg)
+	if err != nil {
+		log.Fatalf("failed to load Ethereum config: %v", err)
+	}
+	plasmaEthCfg := eth.EthPlasmaClientConfig{
+		EthereumURI:      ethCfg.EthereumURI,
 		PlasmaHexAddress: cfg.GetString("root_chain"),
 		PrivateKey:       ethPrivKey,
-		OverrideGas:      true,
+		// go-loom only supports the default fixed gas settings
+		OverrideGas: ethCfg.GasPrice != nil,
 	}
 
-	pbc := eth.NewEthPlasmaClient(ethCfg)
+	pbc := eth.NewEthPlasmaClient(plasmaEthCfg)
 	err = pbc.Init()
 	if err != nil {
 		panic(err) //todo return
 	}
 
-	return &Client{childChain: childChainServer, childBlockInterval: 1000, RootChain: rootChain, TokenContract: tokenContract, plasmaEthClient: pbc}
+	return &Client{
+		childChain:         childChainServer,
+		childBlockInterval: 1000,
+		store:              NewMemCoinStore(),
+		RootChain:          rootChain,
+		TokenContract:      tokenContract,
+		plasmaEthClient:    pbc,
+		exitWatchers:       make(map[uint64]*exitWatcher),
+		challengeWatchers:  make(map[uint64]*challengeWatcher),
+	}
 }
@@ -0,0 +1,233 @@
+package client
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+
+	"github.com/loomnetwork/go-loom/client/plasma_cash"
+)
+
+// Signature modes supported by the RootChain contract (see ECVerify.sol), the mode is stored in
+// the first byte of each tx signature.
+const (
+	sigModeEIP712 byte = iota
+	sigModeGeth
+	sigModeTrezor
+)
+
+// CoinTx is a Plasma Cash transaction of a coin, along with the number of the Plasma block the
+// transaction was included in.
+type CoinTx struct {
+	BlockNum     *big.Int
+	Slot         uint64
+	PrevBlock    *big.Int
+	Denomination *big.Int
+	Owner        common.Address
+	Signature    []byte
+	Proof        []byte
+}
+
+func newCoinTx(blockNum *big.Int, tx plasma_cash.Tx) (*CoinTx, error) {
+	loomTx, ok := tx.(*plasma_cash.LoomTx)
+	if !ok {
+		return nil, fmt.Errorf("unsupported tx type %T", tx)
+	}
+	return &CoinTx{
+		BlockNum:     new(big.Int).Set(blockNum),
+		Slot:         loomTx.Slot,
+		PrevBlock:    loomTx.PrevBlock,
+		Denomination: loomTx.Denomination,
+		Owner:        loomTx.Owner,
+		Signature:    loomTx.Sig(),
+		Proof:        loomTx.Proof(),
+	}, nil
+}
+
+// IsDeposit returns true if the tx is a deposit tx, deposits don't reference any previous block.
+func (t *CoinTx) IsDeposit() bool {
+	return t.PrevBlock == nil || t.PrevBlock.Sign() == 0
+}
+
+// LoomTx converts the tx to a form that can be submitted to the RootChain contract.
+func (t *CoinTx) LoomTx() *plasma_cash.LoomTx {
+	prevBlock := t.PrevBlock
+	if prevBlock == nil {
+		prevBlock = big.NewInt(0)
+	}
+	return &plasma_cash.LoomTx{
+		Slot:         t.Slot,
+		PrevBlock:    prevBlock,
+		Denomination: t.Denomination,
+		Owner:        t.Owner,
+		Signature:    t.Signature,
+		TXProof:      t.Proof,
+	}
+}
+
+// Hash computes the hash of the tx in the same way as the RootChain contract, this is the hash
+// that's signed by the previous owner of the coin, and stored in the Plasma block merkle tree.
+func (t *CoinTx) Hash() ([]byte, error) {
+	if t.IsDeposit() {
+		// Deposit tx hashes only depend on the slot, equivalent to keccak256(abi.encodePacked(slot))
+		slotBytes := make([]byte, 8)
+		binary.BigEndian.PutUint64(slotBytes, t.Slot)
+		return crypto.Keccak256(slotBytes), nil
+	}
+	txBytes, err := t.LoomTx().RlpEncode()
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Keccak256(txBytes), nil
+}
+
+// Signer recovers the address of the entity that signed the tx.
+func (t *CoinTx) Signer() (common.Address, error) {
+	hash, err := t.Hash()
+	if err != nil {
+		return common.Address{}, err
+	}
+	return recoverTxSigner(hash, t.Signature)
+}
+
+// recoverTxSigner recovers the signer of the given tx hash, the signature is expected to be in the
+// format accepted by the RootChain contract: 1 byte mode, followed by r, s, and v.
+func recoverTxSigner(hash []byte, sig []byte) (common.Address, error) {
+	if len(sig) != 66 {
+		return common.Address{}, fmt.Errorf("invalid signature length %d", len(sig))
+	}
+
+	switch sig[0] {
+	case sigModeEIP712:
+	case sigModeGeth:
+		hash = crypto.Keccak256([]byte("\x19Ethereum Signed Message:\n32"), hash)
+	case sigModeTrezor:
+		hash = crypto.Keccak256([]byte("\x19Ethereum Signed Message:\n\x20"), hash)
+	default:
+		return common.Address{}, fmt.Errorf("invalid signature mode %d", sig[0])
+	}
+
+	rsv := make([]byte, 65)
+	copy(rsv, sig[1:])
+	if rsv[64] >= 27 {
+		rsv[64] -= 27
+	}
+	pubKey, err := crypto.SigToPub(hash, rsv)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "failed to recover tx signer")
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// CoinHistory walks through the Plasma blocks from the deposit block of the coin at the given slot
+// to the current block, and returns the chain of valid transactions of the coin (starting with
+// the deposit tx). Txs that don't spend the last valid tx of the coin, or aren't signed by its
+// owner, are excluded from the history.
+func (c *Client) CoinHistory(slot uint64) ([]*CoinTx, error) {
+	return c.CoinHistoryContext(context.Background(), slot)
+}
+
+func (c *Client) CoinHistoryContext(ctx context.Context, slot uint64) ([]*CoinTx, error) {
+	depositTx, err := c.syncCoinHistory(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	txs, err := c.store.Txs(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	history := []*CoinTx{depositTx}
+	for _, coinTx := range txs {
+		lastTx := history[len(history)-1]
+		if coinTx.BlockNum.Cmp(lastTx.BlockNum) <= 0 || coinTx.IsDeposit() ||
+			coinTx.PrevBlock.Cmp(lastTx.BlockNum) != 0 {
+			continue
+		}
+		signer, err := coinTx.Signer()
+		if err != nil || signer != lastTx.Owner {
+			continue
+		}
+		history = append(history, coinTx)
+	}
+	return history, nil
+}
+
+// syncCoinHistory fetches any txs & exclusion proofs of the coin at the given slot that are
+// missing from the local coin store, from the deposit block of the coin up to the current block.
+// Returns the deposit tx of the coin.
+func (c *Client) syncCoinHistory(ctx context.Context, slot uint64) (*CoinTx, error) {
+	coin, err := c.RootChain.PlasmaCoinContext(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	if coin.DepositBlockNum == nil || coin.DepositBlockNum.Sign() == 0 {
+		return nil, fmt.Errorf("slot %d has no deposit on the RootChain", slot)
+	}
+	curBlockNum, err := c.GetBlockNumberContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	depositTx, err := c.syncDepositTx(ctx, slot, coin.DepositBlockNum, coin.Denomination)
+	if err != nil {
+		return nil, err
+	}
+
+	interval := big.NewInt(c.childBlockInterval)
+	// first non-deposit block after the deposit
+	blockNum := new(big.Int).Div(coin.DepositBlockNum, interval)
+	blockNum.Add(blockNum, big.NewInt(1)).Mul(blockNum, interval)
+	for ; blockNum.Cmp(curBlockNum) <= 0; blockNum = new(big.Int).Add(blockNum, interval) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if err := c.syncCoinBlock(ctx, slot, blockNum); err != nil {
+			// The history will have a gap, but that's better than no history at all
+			log.Printf("failed to fetch block %v of slot %d: %v", blockNum, slot, err)
+		}
+	}
+	return depositTx, nil
+}
+
+// syncDepositTx returns the deposit tx of the coin at the given slot, fetching it from the RootChain
+// if it's missing from the local coin store. The owner of the coin on the RootChain changes once the
+// coin is exited, so the depositor is taken from the Deposit event instead.
+func (c *Client) syncDepositTx(ctx context.Context, slot uint64, blockNum *big.Int, denomination *big.Int) (*CoinTx, error) {
+	depositTx, err := c.store.Tx(slot, blockNum)
+	if err == nil {
+		return depositTx, nil
+	}
+	if err != ErrCoinTxNotFound {
+		return nil, err
+	}
+
+	deposits, err := c.RootChain.FilterDepositEvents(&bind.FilterOpts{Context: ctx}, []uint64{slot}, nil, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch deposit of slot %d", slot)
+	}
+	for _, deposit := range deposits {
+		if deposit.BlockNumber.Cmp(blockNum) != 0 {
+			continue
+		}
+		depositTx = &CoinTx{
+			BlockNum:     new(big.Int).Set(blockNum),
+			Slot:         slot,
+			PrevBlock:    big.NewInt(0),
+			Denomination: denomination,
+			Owner:        deposit.From,
+		}
+		if err := c.store.PutTx(depositTx); err != nil {
+			return nil, err
+		}
+		return depositTx, nil
+	}
+	return nil, fmt.Errorf("no Deposit event for slot %d in block %v", slot, blockNum)
+}
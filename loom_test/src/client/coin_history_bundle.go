@@ -0,0 +1,218 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/pkg/errors"
+)
+
+// Current version of the coin history bundle format, should be bumped whenever the format changes
+// in a way that isn't backwards compatible.
+const CoinHistoryBundleVersion uint = 1
+
+// CoinHistoryBundle contains everything the receiver of a coin needs to verify the history of the
+// coin, and to exit or challenge with it. It should be handed over by the sender of a coin to the
+// receiver, out of band, along with the transfer itself.
+type CoinHistoryBundle struct {
+	Version      uint
+	Slot         uint64
+	DepositBlock *big.Int
+	// All the txs of the coin that were included in Plasma blocks (including the deposit tx),
+	// ordered by block number.
+	Txs []*CoinTx
+	// Proofs that the coin wasn't transferred in any of the other Plasma blocks since the
+	// deposit, ordered by block number.
+	Exclusions []*CoinExclusion
+}
+
+// Encode returns the RLP encoding of the bundle.
+func (b *CoinHistoryBundle) Encode() ([]byte, error) {
+	return rlp.EncodeToBytes(b)
+}
+
+// DecodeCoinHistoryBundle decodes an RLP encoded bundle, returns an error if the bundle was encoded
+// using an unsupported version of the format.
+func DecodeCoinHistoryBundle(data []byte) (*CoinHistoryBundle, error) {
+	var header struct {
+		Version uint
+		Rest    []rlp.RawValue `rlp:"tail"`
+	}
+	if err := rlp.DecodeBytes(data, &header); err != nil {
+		return nil, errors.Wrap(err, "failed to decode coin history bundle")
+	}
+	if header.Version != CoinHistoryBundleVersion {
+		return nil, fmt.Errorf("unsupported coin history bundle version %d", header.Version)
+	}
+
+	bundle := &CoinHistoryBundle{}
+	if err := rlp.DecodeBytes(data, bundle); err != nil {
+		return nil, errors.Wrap(err, "failed to decode coin history bundle")
+	}
+	return bundle, nil
+}
+
+// ExportCoinHistory creates a bundle containing the full history of the coin at the given slot,
+// any txs or exclusion proofs missing from the local coin store will be fetched from the operator.
+func (c *Client) ExportCoinHistory(slot uint64) (*CoinHistoryBundle, error) {
+	return c.ExportCoinHistoryContext(context.Background(), slot)
+}
+
+func (c *Client) ExportCoinHistoryContext(ctx context.Context, slot uint64) (*CoinHistoryBundle, error) {
+	depositTx, err := c.syncCoinHistory(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	txs, err := c.store.Txs(slot)
+	if err != nil {
+		return nil, err
+	}
+	exclusions, err := c.store.Exclusions(slot)
+	if err != nil {
+		return nil, err
+	}
+	return &CoinHistoryBundle{
+		Version:      CoinHistoryBundleVersion,
+		Slot:         slot,
+		DepositBlock: depositTx.BlockNum,
+		Txs:          txs,
+		Exclusions:   exclusions,
+	}, nil
+}
+
+// ImportCoinHistory adds the txs & exclusion proofs from the given bundle to the local coin store.
+// Every tx & exclusion proof is verified against the Plasma block roots submitted to the RootChain,
+// and the deposit tx against the Deposit event of the coin, before anything is stored. Entries that
+// are already in the store are never replaced, so a bundle can't rewrite history that was already
+// verified. VerifyCoinHistory should still be used to check that the coin was validly transferred
+// to the receiver once the bundle is imported.
+func (c *Client) ImportCoinHistory(bundle *CoinHistoryBundle) error {
+	return c.ImportCoinHistoryContext(context.Background(), bundle)
+}
+
+func (c *Client) ImportCoinHistoryContext(ctx context.Context, bundle *CoinHistoryBundle) error {
+	if bundle.Version != CoinHistoryBundleVersion {
+		return fmt.Errorf("unsupported coin history bundle version %d", bundle.Version)
+	}
+	coin, err := c.RootChain.PlasmaCoinContext(ctx, bundle.Slot)
+	if err != nil {
+		return err
+	}
+	if bundle.DepositBlock == nil || coin.DepositBlockNum == nil || coin.DepositBlockNum.Sign() == 0 ||
+		bundle.DepositBlock.Cmp(coin.DepositBlockNum) != 0 {
+		return fmt.Errorf("coin history bundle of slot %d doesn't match the deposit on the RootChain", bundle.Slot)
+	}
+	depositTx, err := c.syncDepositTx(ctx, bundle.Slot, coin.DepositBlockNum, coin.Denomination)
+	if err != nil {
+		return err
+	}
+
+	var txs []*CoinTx
+	for _, tx := range bundle.Txs {
+		if tx.Slot != bundle.Slot {
+			return fmt.Errorf("coin history bundle of slot %d contains tx of slot %d", bundle.Slot, tx.Slot)
+		}
+		if tx.BlockNum == nil {
+			return fmt.Errorf("coin history bundle of slot %d contains tx without a block", bundle.Slot)
+		}
+		if tx.BlockNum.Cmp(bundle.DepositBlock) == 0 {
+			// The deposit tx hash only depends on the slot, so the rest of the tx can't be checked
+			// against the block root
+			if !sameCoinHistoryEntry(tx, depositTx) {
+				return fmt.Errorf("coin history bundle of slot %d contains invalid deposit tx", bundle.Slot)
+			}
+			continue
+		}
+		root, err := c.blockRoot(ctx, tx.BlockNum)
+		if err != nil {
+			return err
+		}
+		if err := c.verifyInclusion(root, tx); err != nil {
+			return err
+		}
+		stored, err := c.checkStoredCoinHistory(tx.Slot, tx.BlockNum, tx)
+		if err != nil {
+			return err
+		}
+		if !stored {
+			txs = append(txs, tx)
+		}
+	}
+	var exclusions []*CoinExclusion
+	for _, exclusion := range bundle.Exclusions {
+		if exclusion.Slot != bundle.Slot {
+			return fmt.Errorf("coin history bundle of slot %d contains exclusion proof of slot %d",
+				bundle.Slot, exclusion.Slot)
+		}
+		if exclusion.BlockNum == nil {
+			return fmt.Errorf("coin history bundle of slot %d contains exclusion proof without a block", bundle.Slot)
+		}
+		root, err := c.blockRoot(ctx, exclusion.BlockNum)
+		if err != nil {
+			return err
+		}
+		if err := c.verifyExclusion(root, exclusion); err != nil {
+			return err
+		}
+		stored, err := c.checkStoredCoinHistory(exclusion.Slot, exclusion.BlockNum, exclusion)
+		if err != nil {
+			return err
+		}
+		if !stored {
+			exclusions = append(exclusions, exclusion)
+		}
+	}
+
+	for _, tx := range txs {
+		if err := c.store.PutTx(tx); err != nil {
+			return err
+		}
+	}
+	for _, exclusion := range exclusions {
+		if err := c.store.PutExclusion(exclusion); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkStoredCoinHistory returns true if the given tx or exclusion proof of the coin at the given
+// slot & block is already in the local coin store, and an error if the store contains a different
+// tx or exclusion proof for the same slot & block.
+func (c *Client) checkStoredCoinHistory(slot uint64, blockNum *big.Int, entry interface{}) (bool, error) {
+	var stored interface{}
+	tx, err := c.store.Tx(slot, blockNum)
+	if err == nil {
+		stored = tx
+	} else if err != ErrCoinTxNotFound {
+		return false, err
+	}
+	exclusion, err := c.store.Exclusion(slot, blockNum)
+	if err == nil {
+		stored = exclusion
+	} else if err != ErrCoinTxNotFound {
+		return false, err
+	}
+	if stored == nil {
+		return false, nil
+	}
+	if !sameCoinHistoryEntry(entry, stored) {
+		return false, fmt.Errorf("coin history bundle of slot %d conflicts with stored history for block %v", slot, blockNum)
+	}
+	return true, nil
+}
+
+func sameCoinHistoryEntry(a, b interface{}) bool {
+	aBytes, err := rlp.EncodeToBytes(a)
+	if err != nil {
+		return false
+	}
+	bBytes, err := rlp.EncodeToBytes(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(aBytes, bBytes)
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+	. "gopkg.in/check.v1"
+)
+
+type CoinHistoryBundleTestSuite struct{}
+
+var _ = Suite(&CoinHistoryBundleTestSuite{})
+
+func (s *CoinHistoryBundleTestSuite) TestEncodeDecode(c *C) {
+	bundle := &CoinHistoryBundle{
+		Version:      CoinHistoryBundleVersion,
+		Slot:         5,
+		DepositBlock: big.NewInt(1),
+		Txs: []*CoinTx{
+			{
+				BlockNum:     big.NewInt(1),
+				Slot:         5,
+				PrevBlock:    big.NewInt(0),
+				Denomination: big.NewInt(1),
+				Owner:        common.HexToAddress("0x1111111111111111111111111111111111111111"),
+			},
+			{
+				BlockNum:     big.NewInt(2000),
+				Slot:         5,
+				PrevBlock:    big.NewInt(1),
+				Denomination: big.NewInt(1),
+				Owner:        common.HexToAddress("0x2222222222222222222222222222222222222222"),
+				Signature:    []byte{1, 2, 3},
+				Proof:        []byte{4, 5, 6},
+			},
+		},
+		Exclusions: []*CoinExclusion{
+			{BlockNum: big.NewInt(1000), Slot: 5, Proof: []byte{7, 8}},
+		},
+	}
+	data, err := bundle.Encode()
+	c.Assert(err, IsNil)
+
+	decoded, err := DecodeCoinHistoryBundle(data)
+	c.Assert(err, IsNil)
+	c.Assert(decoded.Slot, Equals, uint64(5))
+	c.Assert(decoded.DepositBlock.Int64(), Equals, int64(1))
+	c.Assert(decoded.Txs, HasLen, 2)
+	c.Assert(decoded.Txs[1].Owner, Equals, bundle.Txs[1].Owner)
+	c.Assert(decoded.Txs[1].Signature, DeepEquals, []byte{1, 2, 3})
+	c.Assert(decoded.Exclusions, HasLen, 1)
+	c.Assert(decoded.Exclusions[0].Proof, DeepEquals, []byte{7, 8})
+}
+
+func (s *CoinHistoryBundleTestSuite) TestDecodeUnsupportedVersion(c *C) {
+	data, err := rlp.EncodeToBytes([]interface{}{CoinHistoryBundleVersion + 1, uint64(5)})
+	c.Assert(err, IsNil)
+	_, err = DecodeCoinHistoryBundle(data)
+	c.Assert(err, ErrorMatches, "unsupported coin history bundle version.*")
+}
+
+func (s *CoinHistoryBundleTestSuite) TestCheckStoredCoinHistory(c *C) {
+	client := &Client{store: NewMemCoinStore()}
+	tx := &CoinTx{
+		BlockNum:     big.NewInt(1000),
+		Slot:         5,
+		PrevBlock:    big.NewInt(1),
+		Denomination: big.NewInt(1),
+		Owner:        common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		Signature:    []byte{1, 2, 3},
+		Proof:        []byte{4, 5, 6},
+	}
+	stored, err := client.checkStoredCoinHistory(5, tx.BlockNum, tx)
+	c.Assert(err, IsNil)
+	c.Assert(stored, Equals, false)
+
+	c.Assert(client.store.PutTx(tx), IsNil)
+	stored, err = client.checkStoredCoinHistory(5, tx.BlockNum, tx)
+	c.Assert(err, IsNil)
+	c.Assert(stored, Equals, true)
+
+	// Stored history mustn't be replaced by a different tx or exclusion proof
+	forged := *tx
+	forged.Owner = common.HexToAddress("0x2222222222222222222222222222222222222222")
+	_, err = client.checkStoredCoinHistory(5, tx.BlockNum, &forged)
+	c.Assert(err, ErrorMatches, ".*conflicts with stored history.*")
+	exclusion := &CoinExclusion{BlockNum: big.NewInt(1000), Slot: 5, Proof: []byte{7, 8}}
+	_, err = client.checkStoredCoinHistory(5, exclusion.BlockNum, exclusion)
+	c.Assert(err, ErrorMatches, ".*conflicts with stored history.*")
+}
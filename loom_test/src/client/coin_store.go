@@ -0,0 +1,298 @@
+package client
+
+import (
+	"encoding/binary"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/pkg/errors"
+)
+
+var ErrCoinTxNotFound = errors.New("coin tx not found")
+
+// CoinStore keeps track of the history of all the coins a client has received, so that the client
+// can exit & challenge without relying on the operator to provide the txs & proofs.
+type CoinStore interface {
+	// PutTx stores a tx of a coin, replacing any tx previously stored for the same slot & block.
+	PutTx(tx *CoinTx) error
+	// Tx returns the tx of the coin at the given slot that was included in the given block,
+	// or ErrCoinTxNotFound if the store doesn't contain such a tx.
+	Tx(slot uint64, blockNum *big.Int) (*CoinTx, error)
+	// Txs returns all the stored txs of the coin at the given slot, ordered by block number.
+	Txs(slot uint64) ([]*CoinTx, error)
+	// PutExclusion stores a proof that a coin wasn't transferred in a block, replacing any proof
+	// previously stored for the same slot & block.
+	PutExclusion(exclusion *CoinExclusion) error
+	// Exclusion returns the proof that the coin at the given slot wasn't transferred in the given
+	// block, or ErrCoinTxNotFound if the store doesn't contain such a proof.
+	Exclusion(slot uint64, blockNum *big.Int) (*CoinExclusion, error)
+	// Exclusions returns all the stored exclusion proofs of the coin at the given slot, ordered by
+	// block number.
+	Exclusions(slot uint64) ([]*CoinExclusion, error)
+	// Slots returns the slots of all the coins with stored txs or exclusion proofs.
+	Slots() ([]uint64, error)
+	// DeleteCoin removes all the stored txs & exclusion proofs of the coin at the given slot.
+	DeleteCoin(slot uint64) error
+	Close() error
+}
+
+// CoinExclusion is a proof that a coin wasn't transferred in a Plasma block.
+type CoinExclusion struct {
+	BlockNum *big.Int
+	Slot     uint64
+	Proof    []byte
+}
+
+var (
+	coinStoreSlotsKey            = []byte("coin_slots")
+	coinStoreHistoryKeyPrefix    = []byte("coin_history")
+	coinStoreExclusionsKeyPrefix = []byte("coin_exclusions")
+)
+
+func coinStoreSlotKey(prefix []byte, slot uint64) []byte {
+	key := make([]byte, len(prefix)+8)
+	copy(key, prefix)
+	binary.BigEndian.PutUint64(key[len(prefix):], slot)
+	return key
+}
+
+func coinStoreHistoryKey(slot uint64) []byte {
+	return coinStoreSlotKey(coinStoreHistoryKeyPrefix, slot)
+}
+
+func coinStoreExclusionsKey(slot uint64) []byte {
+	return coinStoreSlotKey(coinStoreExclusionsKeyPrefix, slot)
+}
+
+// dbCoinStore is a CoinStore that stores the RLP encoded history of each coin in an ethdb.Database.
+type dbCoinStore struct {
+	mutex sync.Mutex
+	db    ethdb.Database
+}
+
+// NewMemCoinStore creates a CoinStore that only keeps the coin history in memory.
+func NewMemCoinStore() CoinStore {
+	return &dbCoinStore{db: ethdb.NewMemDatabase()}
+}
+
+// NewLevelDBCoinStore creates a CoinStore that persists the coin history to a LevelDB database in
+// the given directory.
+func NewLevelDBCoinStore(dir string) (CoinStore, error) {
+	db, err := ethdb.NewLDBDatabase(dir, 16, 16)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open coin store in %s", dir)
+	}
+	return &dbCoinStore{db: db}, nil
+}
+
+func (s *dbCoinStore) PutTx(tx *CoinTx) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	history, err := s.loadHistory(tx.Slot)
+	if err != nil {
+		return err
+	}
+	if err := s.addSlot(tx.Slot); err != nil {
+		return err
+	}
+
+	i := sort.Search(len(history), func(i int) bool {
+		return history[i].BlockNum.Cmp(tx.BlockNum) >= 0
+	})
+	if i < len(history) && history[i].BlockNum.Cmp(tx.BlockNum) == 0 {
+		history[i] = tx
+	} else {
+		history = append(history, nil)
+		copy(history[i+1:], history[i:])
+		history[i] = tx
+	}
+	return s.saveHistory(tx.Slot, history)
+}
+
+func (s *dbCoinStore) Tx(slot uint64, blockNum *big.Int) (*CoinTx, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	history, err := s.loadHistory(slot)
+	if err != nil {
+		return nil, err
+	}
+	for _, tx := range history {
+		if tx.BlockNum.Cmp(blockNum) == 0 {
+			return tx, nil
+		}
+	}
+	return nil, ErrCoinTxNotFound
+}
+
+func (s *dbCoinStore) Txs(slot uint64) ([]*CoinTx, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.loadHistory(slot)
+}
+
+func (s *dbCoinStore) PutExclusion(exclusion *CoinExclusion) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	exclusions, err := s.loadExclusions(exclusion.Slot)
+	if err != nil {
+		return err
+	}
+	if err := s.addSlot(exclusion.Slot); err != nil {
+		return err
+	}
+
+	i := sort.Search(len(exclusions), func(i int) bool {
+		return exclusions[i].BlockNum.Cmp(exclusion.BlockNum) >= 0
+	})
+	if i < len(exclusions) && exclusions[i].BlockNum.Cmp(exclusion.BlockNum) == 0 {
+		exclusions[i] = exclusion
+	} else {
+		exclusions = append(exclusions, nil)
+		copy(exclusions[i+1:], exclusions[i:])
+		exclusions[i] = exclusion
+	}
+	return s.saveExclusions(exclusion.Slot, exclusions)
+}
+
+func (s *dbCoinStore) Exclusion(slot uint64, blockNum *big.Int) (*CoinExclusion, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	exclusions, err := s.loadExclusions(slot)
+	if err != nil {
+		return nil, err
+	}
+	for _, exclusion := range exclusions {
+		if exclusion.BlockNum.Cmp(blockNum) == 0 {
+			return exclusion, nil
+		}
+	}
+	return nil, ErrCoinTxNotFound
+}
+
+func (s *dbCoinStore) Exclusions(slot uint64) ([]*CoinExclusion, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.loadExclusions(slot)
+}
+
+func (s *dbCoinStore) Slots() ([]uint64, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.loadSlots()
+}
+
+func (s *dbCoinStore) DeleteCoin(slot uint64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	slots, err := s.loadSlots()
+	if err != nil {
+		return err
+	}
+	for i, v := range slots {
+		if v == slot {
+			slots = append(slots[:i], slots[i+1:]...)
+			break
+		}
+	}
+	if err := s.saveSlots(slots); err != nil {
+		return err
+	}
+	if err := s.db.Delete(coinStoreExclusionsKey(slot)); err != nil {
+		return err
+	}
+	return s.db.Delete(coinStoreHistoryKey(slot))
+}
+
+func (s *dbCoinStore) Close() error {
+	s.db.Close()
+	return nil
+}
+
+func (s *dbCoinStore) loadHistory(slot uint64) ([]*CoinTx, error) {
+	var history []*CoinTx
+	if err := s.load(coinStoreHistoryKey(slot), &history); err != nil {
+		return nil, errors.Wrapf(err, "failed to load history of slot %d", slot)
+	}
+	return history, nil
+}
+
+func (s *dbCoinStore) saveHistory(slot uint64, history []*CoinTx) error {
+	if err := s.save(coinStoreHistoryKey(slot), history); err != nil {
+		return errors.Wrapf(err, "failed to save history of slot %d", slot)
+	}
+	return nil
+}
+
+func (s *dbCoinStore) loadExclusions(slot uint64) ([]*CoinExclusion, error) {
+	var exclusions []*CoinExclusion
+	if err := s.load(coinStoreExclusionsKey(slot), &exclusions); err != nil {
+		return nil, errors.Wrapf(err, "failed to load exclusion proofs of slot %d", slot)
+	}
+	return exclusions, nil
+}
+
+func (s *dbCoinStore) saveExclusions(slot uint64, exclusions []*CoinExclusion) error {
+	if err := s.save(coinStoreExclusionsKey(slot), exclusions); err != nil {
+		return errors.Wrapf(err, "failed to save exclusion proofs of slot %d", slot)
+	}
+	return nil
+}
+
+func (s *dbCoinStore) loadSlots() ([]uint64, error) {
+	var slots []uint64
+	if err := s.load(coinStoreSlotsKey, &slots); err != nil {
+		return nil, errors.Wrap(err, "failed to load slots")
+	}
+	return slots, nil
+}
+
+func (s *dbCoinStore) saveSlots(slots []uint64) error {
+	if err := s.save(coinStoreSlotsKey, slots); err != nil {
+		return errors.Wrap(err, "failed to save slots")
+	}
+	return nil
+}
+
+func (s *dbCoinStore) addSlot(slot uint64) error {
+	slots, err := s.loadSlots()
+	if err != nil {
+		return err
+	}
+	for _, v := range slots {
+		if v == slot {
+			return nil
+		}
+	}
+	return s.saveSlots(append(slots, slot))
+}
+
+func (s *dbCoinStore) load(key []byte, val interface{}) error {
+	has, err := s.db.Has(key)
+	if err != nil || !has {
+		return err
+	}
+	data, err := s.db.Get(key)
+	if err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data, val)
+}
+
+func (s *dbCoinStore) save(key []byte, val interface{}) error {
+	data, err := rlp.EncodeToBytes(val)
+	if err != nil {
+		return err
+	}
+	return s.db.Put(key, data)
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"math/big"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "gopkg.in/check.v1"
+)
+
+type CoinStoreTestSuite struct{}
+
+var _ = Suite(&CoinStoreTestSuite{})
+
+func (s *CoinStoreTestSuite) TestMemCoinStore(c *C) {
+	store := NewMemCoinStore()
+	defer store.Close()
+	testCoinStore(c, store)
+}
+
+func (s *CoinStoreTestSuite) TestLevelDBCoinStore(c *C) {
+	dir := filepath.Join(c.MkDir(), "coins")
+	store, err := NewLevelDBCoinStore(dir)
+	c.Assert(err, IsNil)
+	testCoinStore(c, store)
+	c.Assert(store.Close(), IsNil)
+
+	// Reopen the store to check the history was persisted
+	store, err = NewLevelDBCoinStore(dir)
+	c.Assert(err, IsNil)
+	defer store.Close()
+	txs, err := store.Txs(5)
+	c.Assert(err, IsNil)
+	c.Assert(txs, HasLen, 2)
+}
+
+func testCoinStore(c *C, store CoinStore) {
+	owner := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	tx1 := &CoinTx{
+		BlockNum:     big.NewInt(3000),
+		Slot:         5,
+		PrevBlock:    big.NewInt(1000),
+		Denomination: big.NewInt(1),
+		Owner:        owner,
+		Signature:    []byte{1, 2, 3},
+		Proof:        []byte{4, 5, 6},
+	}
+	tx2 := &CoinTx{
+		BlockNum:     big.NewInt(1000),
+		Slot:         5,
+		PrevBlock:    big.NewInt(1),
+		Denomination: big.NewInt(1),
+		Owner:        owner,
+		Signature:    []byte{7},
+		Proof:        []byte{8},
+	}
+
+	_, err := store.Tx(5, big.NewInt(1000))
+	c.Assert(err, Equals, ErrCoinTxNotFound)
+
+	c.Assert(store.PutTx(tx1), IsNil)
+	c.Assert(store.PutTx(tx2), IsNil)
+	// Storing the same tx again shouldn't create a duplicate
+	c.Assert(store.PutTx(tx2), IsNil)
+	c.Assert(store.PutTx(&CoinTx{BlockNum: big.NewInt(1), Slot: 6, Owner: owner}), IsNil)
+
+	tx, err := store.Tx(5, big.NewInt(3000))
+	c.Assert(err, IsNil)
+	c.Assert(tx.PrevBlock.Int64(), Equals, int64(1000))
+	c.Assert(tx.Owner, Equals, owner)
+	c.Assert(tx.Signature, DeepEquals, []byte{1, 2, 3})
+	c.Assert(tx.Proof, DeepEquals, []byte{4, 5, 6})
+
+	// Txs should be ordered by block number
+	txs, err := store.Txs(5)
+	c.Assert(err, IsNil)
+	c.Assert(txs, HasLen, 2)
+	c.Assert(txs[0].BlockNum.Int64(), Equals, int64(1000))
+	c.Assert(txs[1].BlockNum.Int64(), Equals, int64(3000))
+
+	_, err = store.Exclusion(5, big.NewInt(2000))
+	c.Assert(err, Equals, ErrCoinTxNotFound)
+	c.Assert(store.PutExclusion(&CoinExclusion{BlockNum: big.NewInt(2000), Slot: 5, Proof: []byte{9}}), IsNil)
+	exclusion, err := store.Exclusion(5, big.NewInt(2000))
+	c.Assert(err, IsNil)
+	c.Assert(exclusion.Proof, DeepEquals, []byte{9})
+	c.Assert(store.PutExclusion(&CoinExclusion{BlockNum: big.NewInt(2000), Slot: 6}), IsNil)
+
+	slots, err := store.Slots()
+	c.Assert(err, IsNil)
+	c.Assert(slots, DeepEquals, []uint64{5, 6})
+
+	c.Assert(store.DeleteCoin(6), IsNil)
+	slots, err = store.Slots()
+	c.Assert(err, IsNil)
+	c.Assert(slots, DeepEquals, []uint64{5})
+	txs, err = store.Txs(6)
+	c.Assert(err, IsNil)
+	c.Assert(txs, HasLen, 0)
+	exclusions, err := store.Exclusions(6)
+	c.Assert(err, IsNil)
+	c.Assert(exclusions, HasLen, 0)
+}
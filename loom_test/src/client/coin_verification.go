@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"smt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// VerifyCoinHistory verifies the full history of the coin at the given slot, from its deposit up to
+// the current Plasma block, against the Plasma block roots submitted to the RootChain. For every
+// Plasma block the local coin store (or the operator, if the store is missing data) must provide
+// either a tx of the coin along with a valid inclusion proof, or a valid exclusion proof. The txs
+// must form an unbroken chain of spends, each one signed by the owner of the coin in the previous
+// tx. Txs that are included in a block but don't spend the coin in a valid manner are ignored,
+// since they can be challenged if anyone ever attempts to exit them. Returns the last valid tx of
+// the coin, the receiver of a coin must check that they're the owner in that tx before accepting
+// the coin.
+func (c *Client) VerifyCoinHistory(slot uint64) (*CoinTx, error) {
+	return c.VerifyCoinHistoryContext(context.Background(), slot)
+}
+
+func (c *Client) VerifyCoinHistoryContext(ctx context.Context, slot uint64) (*CoinTx, error) {
+	depositTx, err := c.syncCoinHistory(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	curBlockNum, err := c.GetBlockNumberContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	txs, err := c.store.Txs(slot)
+	if err != nil {
+		return nil, err
+	}
+	exclusions, err := c.store.Exclusions(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	txsByBlock := make(map[string]*CoinTx)
+	for _, tx := range txs {
+		txsByBlock[tx.BlockNum.String()] = tx
+	}
+	exclusionsByBlock := make(map[string]*CoinExclusion)
+	for _, exclusion := range exclusions {
+		exclusionsByBlock[exclusion.BlockNum.String()] = exclusion
+	}
+
+	// The root of a deposit block is the hash of the deposit tx
+	depositRoot, err := c.blockRoot(ctx, depositTx.BlockNum)
+	if err != nil {
+		return nil, err
+	}
+	depositHash, err := depositTx.Hash()
+	if err != nil {
+		return nil, err
+	}
+	if common.BytesToHash(depositHash) != depositRoot {
+		return nil, fmt.Errorf("deposit of slot %d doesn't match root of block %v", slot, depositTx.BlockNum)
+	}
+
+	lastTx := depositTx
+	interval := big.NewInt(c.childBlockInterval)
+	// first non-deposit block after the deposit
+	blockNum := new(big.Int).Div(depositTx.BlockNum, interval)
+	blockNum.Add(blockNum, big.NewInt(1)).Mul(blockNum, interval)
+	for ; blockNum.Cmp(curBlockNum) <= 0; blockNum = new(big.Int).Add(blockNum, interval) {
+		root, err := c.blockRoot(ctx, blockNum)
+		if err != nil {
+			return nil, err
+		}
+
+		if tx, exists := txsByBlock[blockNum.String()]; exists {
+			if err := c.verifyInclusion(root, tx); err != nil {
+				return nil, err
+			}
+			if tx.IsDeposit() || tx.PrevBlock.Cmp(lastTx.BlockNum) != 0 {
+				continue
+			}
+			if signer, err := tx.Signer(); err != nil || signer != lastTx.Owner {
+				continue
+			}
+			lastTx = tx
+		} else if exclusion, exists := exclusionsByBlock[blockNum.String()]; exists {
+			if err := c.verifyExclusion(root, exclusion); err != nil {
+				return nil, err
+			}
+		} else {
+			return nil, fmt.Errorf("slot %d has no tx or exclusion proof for block %v", slot, blockNum)
+		}
+	}
+	return lastTx, nil
+}
+
+// blockRoot returns the root of the given Plasma block, or an error if the block hasn't been
+// submitted to the RootChain yet.
+func (c *Client) blockRoot(ctx context.Context, blockNum *big.Int) (common.Hash, error) {
+	root, err := c.RootChain.BlockRoot(ctx, blockNum)
+	if err != nil {
+		return common.Hash{}, errors.Wrapf(err, "failed to retrieve root of block %v", blockNum)
+	}
+	if root == ([32]byte{}) {
+		return common.Hash{}, fmt.Errorf("block %v hasn't been submitted to the RootChain", blockNum)
+	}
+	return common.Hash(root), nil
+}
+
+// verifyInclusion returns an error if the given tx isn't included in the Plasma block with the
+// given root.
+func (c *Client) verifyInclusion(root common.Hash, tx *CoinTx) error {
+	hash, err := tx.Hash()
+	if err != nil {
+		return err
+	}
+	var included bool
+	if c.isDepositBlock(tx.BlockNum) {
+		// The root of a deposit block is the hash of the deposit tx
+		included = common.BytesToHash(hash) == root
+	} else {
+		included, err = smt.CheckMembership(common.BytesToHash(hash), root, tx.Slot, tx.Proof)
+		if err != nil {
+			return err
+		}
+	}
+	if !included {
+		return fmt.Errorf("tx of slot %d has invalid inclusion proof for block %v", tx.Slot, tx.BlockNum)
+	}
+	return nil
+}
+
+// verifyExclusion returns an error if the given exclusion proof doesn't prove that the coin wasn't
+// transferred in the Plasma block with the given root.
+func (c *Client) verifyExclusion(root common.Hash, exclusion *CoinExclusion) error {
+	var excluded bool
+	if c.isDepositBlock(exclusion.BlockNum) {
+		// A deposit block only contains the deposit tx, so any other coin is excluded from it
+		depositHash, err := (&CoinTx{Slot: exclusion.Slot}).Hash()
+		if err != nil {
+			return err
+		}
+		excluded = common.BytesToHash(depositHash) != root
+	} else {
+		var err error
+		excluded, err = smt.CheckExclusion(root, exclusion.Slot, exclusion.Proof)
+		if err != nil {
+			return err
+		}
+	}
+	if !excluded {
+		return fmt.Errorf("slot %d has invalid exclusion proof for block %v", exclusion.Slot, exclusion.BlockNum)
+	}
+	return nil
+}
+
+func (c *Client) isDepositBlock(blockNum *big.Int) bool {
+	return new(big.Int).Mod(blockNum, big.NewInt(c.childBlockInterval)).Sign() != 0
+}
@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/loomnetwork/go-loom/client/plasma_cash"
+)
+
+const (
+	// How often DepositAndWait checks if the deposit block is available on the DAppChain.
+	DepositPollInterval = 1 * time.Second
+	// How long DepositAndWait waits for a deposit to be mined & show up on the DAppChain, unless
+	// the context passed to DepositAndWait has its own deadline.
+	DepositTimeout = 2 * time.Minute
+)
+
+var ErrDepositTimeout = errors.New("timed out waiting for deposit")
+
+// DepositedCoin describes a coin that was deposited into the RootChain contract.
+type DepositedCoin struct {
+	Slot uint64
+	// Plasma block containing the deposit tx
+	BlockNum     *big.Int
+	Denomination *big.Int
+	Owner        common.Address
+	// Token contract the coin was deposited from
+	ContractAddress common.Address
+	// State of the coin on the RootChain once the deposit block was available on the DAppChain
+	Coin *plasma_cash.PlasmaCoin
+	// Ethereum tx that deposited the coin
+	EthTxHash common.Hash
+}
+
+// DepositAndWait deposits the given token, and waits for the deposit block to become available
+// on the DAppChain.
+func (c *Client) DepositAndWait(tokenID *big.Int) (*DepositedCoin, error) {
+	return c.DepositAndWaitContext(context.Background(), tokenID)
+}
+
+// DepositAndWaitContext deposits the given token, waits for the deposit tx to be mined, and then
+// waits for the deposit block to become available on the DAppChain. The deposit tx is added to
+// the local coin store so the coin can be transferred or exited straight away.
+func (c *Client) DepositAndWaitContext(ctx context.Context, tokenID *big.Int) (*DepositedCoin, error) {
+	return c.depositAndWait(ctx, func(ctx context.Context) (common.Hash, error) {
+		return c.DepositContext(ctx, tokenID)
+	})
+}
+
+// DepositETHAndWait deposits the given amount of ETH (in wei), and waits for the deposit block to
+// become available on the DAppChain.
+func (c *Client) DepositETHAndWait(amount *big.Int) (*DepositedCoin, error) {
+	return c.DepositETHAndWaitContext(context.Background(), amount)
+}
+
+func (c *Client) DepositETHAndWaitContext(ctx context.Context, amount *big.Int) (*DepositedCoin, error) {
+	return c.depositAndWait(ctx, func(ctx context.Context) (common.Hash, error) {
+		return c.RootChain.DepositETHTx(ctx, amount)
+	})
+}
+
+// DepositERC20AndWait deposits the given amount of tokens from an ERC20 contract, and waits for
+// the deposit block to become available on the DAppChain.
+func (c *Client) DepositERC20AndWait(tokenAddr common.Address, amount *big.Int) (*DepositedCoin, error) {
+	return c.DepositERC20AndWaitContext(context.Background(), tokenAddr, amount)
+}
+
+func (c *Client) DepositERC20AndWaitContext(ctx context.Context, tokenAddr common.Address, amount *big.Int) (*DepositedCoin, error) {
+	return c.depositAndWait(ctx, func(ctx context.Context) (common.Hash, error) {
+		return c.RootChain.DepositERC20Tx(ctx, tokenAddr, amount)
+	})
+}
+
+// DepositERC721AndWait deposits a token from any ERC721 contract, and waits for the deposit block
+// to become available on the DAppChain.
+func (c *Client) DepositERC721AndWait(tokenAddr common.Address, uid *big.Int) (*DepositedCoin, error) {
+	return c.DepositERC721AndWaitContext(context.Background(), tokenAddr, uid)
+}
+
+func (c *Client) DepositERC721AndWaitContext(ctx context.Context, tokenAddr common.Address, uid *big.Int) (*DepositedCoin, error) {
+	return c.depositAndWait(ctx, func(ctx context.Context) (common.Hash, error) {
+		return c.RootChain.DepositERC721Tx(ctx, tokenAddr, uid)
+	})
+}
+
+// depositAndWait sends a deposit tx using the given function, waits for the deposit tx to be
+// mined, and then waits for the deposit block to become available on the DAppChain.
+func (c *Client) depositAndWait(
+	ctx context.Context, deposit func(ctx context.Context) (common.Hash, error),
+) (*DepositedCoin, error) {
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, DepositTimeout)
+		defer cancel()
+	}
+
+	txHash, err := deposit(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to send deposit tx")
+	}
+	receipt, err := c.RootChain.WaitMined(ctx, txHash)
+	if err != nil {
+		return nil, errors.Wrap(err, "deposit failed")
+	}
+	deposits, err := receipt.DepositEvents()
+	if err != nil {
+		return nil, err
+	}
+	if len(deposits) != 1 {
+		return nil, errors.Errorf("deposit tx %s emitted %d Deposit events, expected 1", txHash.Hex(), len(deposits))
+	}
+	deposit := deposits[0]
+
+	if err := c.waitForDepositBlock(ctx, deposit.BlockNumber); err != nil {
+		return nil, errors.Wrapf(err, "slot %d", deposit.Slot)
+	}
+
+	coin, err := c.RootChain.PlasmaCoinContext(ctx, deposit.Slot)
+	if err != nil {
+		return nil, err
+	}
+	err = c.store.PutTx(&CoinTx{
+		BlockNum:     deposit.BlockNumber,
+		Slot:         deposit.Slot,
+		PrevBlock:    big.NewInt(0),
+		Denomination: deposit.Denomination,
+		Owner:        deposit.From,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &DepositedCoin{
+		Slot:            deposit.Slot,
+		BlockNum:        deposit.BlockNumber,
+		Denomination:    deposit.Denomination,
+		Owner:           deposit.From,
+		ContractAddress: deposit.ContractAddress,
+		Coin:            coin,
+		EthTxHash:       txHash,
+	}, nil
+}
+
+// waitForDepositBlock waits until the DAppChain returns the given deposit block.
+func (c *Client) waitForDepositBlock(ctx context.Context, blockNum *big.Int) error {
+	ticker := time.NewTicker(DepositPollInterval)
+	defer ticker.Stop()
+
+	var lastErr error
+	for {
+		block, err := c.GetBlockContext(ctx, blockNum)
+		if err == nil && block != nil {
+			return nil
+		}
+		if err != nil {
+			lastErr = err
+		}
+		select {
+		case <-ctx.Done():
+			if ctx.Err() != context.DeadlineExceeded {
+				return ctx.Err()
+			}
+			if lastErr != nil {
+				return errors.Wrapf(ErrDepositTimeout, "deposit block %v unavailable: %v", blockNum, lastErr)
+			}
+			return errors.Wrapf(ErrDepositTimeout, "deposit block %v unavailable", blockNum)
+		case <-ticker.C:
+		}
+	}
+}
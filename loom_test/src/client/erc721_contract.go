@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"ethcontract"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/loomnetwork/go-loom/client/plasma_cash"
+	"github.com/pkg/errors"
+)
+
+// ERC721Contract is a TokenContract for any ERC721 token contract. Tokens are deposited by
+// transferring them to the RootChain contract with safeTransferFrom, which requires the token
+// contract to have been approved by the RootChain validators.
+type ERC721Contract struct {
+	Name          string
+	contract      *ethcontract.ERC721
+	contractAddr  common.Address
+	rootChainAddr common.Address
+	callerKey     *ecdsa.PrivateKey
+	callerAddr    common.Address
+	transactor    *transactor
+}
+
+// NewERC721Contract creates a wrapper for the ERC721 token contract at the given address, tokens
+// will be deposited into the RootChain contract at rootChainAddr. Txs are sent with the given nonce
+// manager, which may be nil if no other wrapper sends txs from the caller's account.
+func NewERC721Contract(backend Backend, callerName string, callerKey *ecdsa.PrivateKey,
+	contractAddr common.Address, rootChainAddr common.Address, ethCfg *EthConfig, nonces *NonceManager) (*ERC721Contract, error) {
+	contract, err := ethcontract.NewERC721(contractAddr, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721Contract{
+		Name:          callerName,
+		contract:      contract,
+		contractAddr:  contractAddr,
+		rootChainAddr: rootChainAddr,
+		callerKey:     callerKey,
+		callerAddr:    crypto.PubkeyToAddress(callerKey.PublicKey),
+		transactor:    newTransactor(backend, callerKey, ethCfg, nonces),
+	}, nil
+}
+
+func (d *ERC721Contract) callOpts(ctx context.Context) *bind.CallOpts {
+	return &bind.CallOpts{From: d.callerAddr, Context: ctx}
+}
+
+// Register is a no-op, generic ERC721 contracts have no way to mint tokens for demo purposes.
+func (d *ERC721Contract) Register() error {
+	return d.RegisterContext(context.Background())
+}
+
+func (d *ERC721Contract) RegisterContext(ctx context.Context) error {
+	return nil
+}
+
+func (d *ERC721Contract) Deposit(tokenID *big.Int) (common.Hash, error) {
+	return d.DepositContext(context.Background(), tokenID)
+}
+
+func (d *ERC721Contract) DepositContext(ctx context.Context, tokenID *big.Int) (common.Hash, error) {
+	return d.transactor.transact(&txOptions{Context: ctx}, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return d.contract.SafeTransferFrom(opts, d.callerAddr, d.rootChainAddr, tokenID, nil)
+	})
+}
+
+func (d *ERC721Contract) BalanceOf() (*big.Int, error) {
+	return d.BalanceOfContext(context.Background())
+}
+
+func (d *ERC721Contract) BalanceOfContext(ctx context.Context) (*big.Int, error) {
+	bal, err := d.contract.BalanceOf(d.callOpts(ctx), d.callerAddr)
+	if err != nil {
+		return big.NewInt(0), err
+	}
+	return bal, nil
+}
+
+func (d *ERC721Contract) Account() (*plasma_cash.Account, error) {
+	return &plasma_cash.Account{
+		Address:    d.callerAddr.String(),
+		PrivateKey: d.callerKey,
+	}, nil
+}
+
+// OwnerOf returns the current owner of the given token.
+func (d *ERC721Contract) OwnerOf(ctx context.Context, tokenID *big.Int) (common.Address, error) {
+	owner, err := d.contract.OwnerOf(d.callOpts(ctx), tokenID)
+	if err != nil {
+		return common.Address{}, errors.Wrapf(err, "failed to query owner of token %v", tokenID)
+	}
+	return owner, nil
+}
+
+// TokensOf returns the IDs of all the tokens owned by the given account, the token contract must
+// implement the ERC721Enumerable extension.
+func (d *ERC721Contract) TokensOf(ctx context.Context, owner common.Address) ([]*big.Int, error) {
+	bal, err := d.contract.BalanceOf(d.callOpts(ctx), owner)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query token balance of %s", owner.Hex())
+	}
+	count := bal.Int64()
+	tokens := make([]*big.Int, 0, count)
+	for i := int64(0); i < count; i++ {
+		tokenID, err := d.contract.TokenOfOwnerByIndex(d.callOpts(ctx), owner, big.NewInt(i))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to query token %d of %s", i, owner.Hex())
+		}
+		tokens = append(tokens, tokenID)
+	}
+	return tokens, nil
+}
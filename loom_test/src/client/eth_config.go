@@ -0,0 +1,134 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/spf13/viper"
+)
+
+const (
+	DefaultEthereumURI = "http://localhost:8545"
+	// If gas price isn't set explicitely then go-ethereum will attempt to query the suggested gas
+	// price, unfortunatley ganache-cli v6.1.2 seems to encode the gas price in a format go-ethereum
+	// can't decode correctly, so this error is returned whenver you attempt to call a contract:
+	// failed to suggest gas price: json: cannot unmarshal hex number with leading zero digits into Go value of type *hexutil.Big
+	//
+	// Earlier versions of ganache-cli don't seem to exhibit this issue, but they're broken in other
+	// ways (logs aren't hex-encoded correctly).
+	// So by default a fixed gas price & limit are used, which works fine with ganache.
+	DefaultGasPrice = 20000
+	DefaultGasLimit = 3141592
+)
+
+// EthConfig contains the Ethereum network settings used by the clients.
+type EthConfig struct {
+	// Name of the network profile the settings were loaded from, empty if the config doesn't
+	// contain any network profiles.
+	Network     string
+	EthereumURI string
+	// Fixed gas price to use for all txs, nil if the gas price oracle of the Ethereum node
+	// (eth_gasPrice) should be queried for each tx instead.
+	GasPrice *big.Int
+	// Fixed gas limit to use for all txs, zero if the gas limit should be estimated for each tx.
+	GasLimit uint64
+	// Bond to send along with exits & challenges, in wei, the bond is always queried from the
+	// RootChain contract, if this isn't nil the contract bond must match it.
+	ExitBond *big.Int
+}
+
+// LoadEthConfig loads the Ethereum network settings from the given config.
+//
+// The config may contain multiple network profiles under the "networks" key, in which case the
+// profile named by the PLASMA_NETWORK env var, or the "network" key if the env var isn't set, is
+// used. Each profile may contain the following settings:
+//
+//	ethereum_uri:     URI of the Ethereum node, defaults to http://localhost:8545
+//	gas_price:        fixed gas price in wei, defaults to 20000
+//	gas_price_oracle: if true the gas price suggested by the Ethereum node is used instead of gas_price
+//	gas_limit:        fixed gas limit, defaults to 3141592, set to 0 to estimate the gas limit of each tx
+//	exit_bond:        bond to send with exits & challenges in wei, queried from the RootChain if not set
+//
+// If the config doesn't contain any network profiles the settings are read from the top level of
+// the config instead.
+func LoadEthConfig(cfg *viper.Viper) (*EthConfig, error) {
+	network := os.Getenv("PLASMA_NETWORK")
+	if network == "" {
+		network = cfg.GetString("network")
+	}
+
+	settings := cfg
+	if cfg.IsSet("networks") {
+		if network == "" {
+			return nil, fmt.Errorf("no Ethereum network selected")
+		}
+		settings = cfg.Sub("networks." + network)
+		if settings == nil {
+			return nil, fmt.Errorf("Ethereum network %s not found in config", network)
+		}
+	} else if network != "" {
+		return nil, fmt.Errorf("Ethereum network %s not found in config", network)
+	}
+
+	// Without network profiles settings is the caller's config, so defaults mustn't be set on it
+	ethereumURI := DefaultEthereumURI
+	if settings.IsSet("ethereum_uri") {
+		ethereumURI = settings.GetString("ethereum_uri")
+	}
+	gasLimit := int64(DefaultGasLimit)
+	if settings.IsSet("gas_limit") {
+		gasLimit = settings.GetInt64("gas_limit")
+	}
+	if gasLimit < 0 {
+		return nil, fmt.Errorf("invalid gas limit %d", gasLimit)
+	}
+	ethCfg := &EthConfig{
+		Network:     network,
+		EthereumURI: ethereumURI,
+		GasLimit:    uint64(gasLimit),
+	}
+	if !settings.GetBool("gas_price_oracle") {
+		gasPriceStr := fmt.Sprint(DefaultGasPrice)
+		if settings.IsSet("gas_price") {
+			gasPriceStr = settings.GetString("gas_price")
+		}
+		gasPrice, ok := new(big.Int).SetString(gasPriceStr, 10)
+		if !ok || gasPrice.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid gas price %s", gasPriceStr)
+		}
+		ethCfg.GasPrice = gasPrice
+	}
+	if settings.IsSet("exit_bond") {
+		exitBond, ok := new(big.Int).SetString(settings.GetString("exit_bond"), 10)
+		if !ok || exitBond.Sign() < 0 {
+			return nil, fmt.Errorf("invalid exit bond %s", settings.GetString("exit_bond"))
+		}
+		ethCfg.ExitBond = exitBond
+	}
+	return ethCfg, nil
+}
+
+// LoadDefaultEthConfig loads the Ethereum network settings from plasma-config.yml.
+func LoadDefaultEthConfig() (*EthConfig, error) {
+	cfg, err := parseConfig()
+	if err != nil {
+		return nil, err
+	}
+	return LoadEthConfig(cfg)
+}
+
+// NewTransactor creates a transactor that signs txs with the given key, and applies the gas
+// settings from the config. When the gas price or limit aren't fixed they're left unset, in which
+// case go-ethereum queries the gas price oracle of the Ethereum node, and estimates the gas limit,
+// whenever a tx is sent.
+func (c *EthConfig) NewTransactor(key *ecdsa.PrivateKey) *bind.TransactOpts {
+	auth := bind.NewKeyedTransactor(key)
+	if c.GasPrice != nil {
+		auth.GasPrice = new(big.Int).Set(c.GasPrice)
+	}
+	auth.GasLimit = c.GasLimit
+	return auth
+}
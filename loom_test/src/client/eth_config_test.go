@@ -0,0 +1,74 @@
+package client
+
+import (
+	"bytes"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/viper"
+	. "gopkg.in/check.v1"
+)
+
+type EthConfigTestSuite struct{}
+
+var _ = Suite(&EthConfigTestSuite{})
+
+func parseTestConfig(c *C, yaml string) *viper.Viper {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	c.Assert(v.ReadConfig(bytes.NewBufferString(yaml)), IsNil)
+	return v
+}
+
+func (s *EthConfigTestSuite) TestDefaults(c *C) {
+	cfg := parseTestConfig(c, `root_chain: "0x01"`)
+	ethCfg, err := LoadEthConfig(cfg)
+	c.Assert(err, IsNil)
+	c.Assert(ethCfg.EthereumURI, Equals, DefaultEthereumURI)
+	c.Assert(ethCfg.GasPrice.Int64(), Equals, int64(DefaultGasPrice))
+	c.Assert(ethCfg.GasLimit, Equals, uint64(DefaultGasLimit))
+	// The defaults mustn't leak into the caller's config
+	c.Assert(cfg.IsSet("ethereum_uri"), Equals, false)
+	c.Assert(cfg.IsSet("gas_price"), Equals, false)
+	c.Assert(cfg.IsSet("gas_limit"), Equals, false)
+}
+
+func (s *EthConfigTestSuite) TestNetworkProfiles(c *C) {
+	cfg := parseTestConfig(c, `
+network: ganache
+networks:
+  ganache:
+    ethereum_uri: "http://localhost:7545"
+    gas_price: 1000
+    exit_bond: 50000000000000000
+  testnet:
+    ethereum_uri: "https://testnet.example.com"
+    gas_price_oracle: true
+    gas_limit: 0
+`)
+	ethCfg, err := LoadEthConfig(cfg)
+	c.Assert(err, IsNil)
+	c.Assert(ethCfg.Network, Equals, "ganache")
+	c.Assert(ethCfg.EthereumURI, Equals, "http://localhost:7545")
+	c.Assert(ethCfg.GasPrice.Int64(), Equals, int64(1000))
+	c.Assert(ethCfg.GasLimit, Equals, uint64(DefaultGasLimit))
+	c.Assert(ethCfg.ExitBond.String(), Equals, "50000000000000000")
+
+	cfg.Set("network", "testnet")
+	ethCfg, err = LoadEthConfig(cfg)
+	c.Assert(err, IsNil)
+	c.Assert(ethCfg.EthereumURI, Equals, "https://testnet.example.com")
+	c.Assert(ethCfg.GasPrice, IsNil)
+	c.Assert(ethCfg.GasLimit, Equals, uint64(0))
+	c.Assert(ethCfg.ExitBond, IsNil)
+
+	// Unset gas settings should be left for go-ethereum to figure out
+	key, err := crypto.GenerateKey()
+	c.Assert(err, IsNil)
+	auth := ethCfg.NewTransactor(key)
+	c.Assert(auth.GasPrice, IsNil)
+	c.Assert(auth.GasLimit, Equals, uint64(0))
+
+	cfg.Set("network", "mainnet")
+	_, err = LoadEthConfig(cfg)
+	c.Assert(err, NotNil)
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// eventPoller periodically calls the poll func with the range of Ethereum blocks that have been
+// mined since the previous call, it's used to watch for RootChain events without requiring a
+// websocket connection to the Ethereum node.
+type eventPoller struct {
+	name         string
+	rootChain    RootChainClient
+	pollInterval time.Duration
+	nextBlock    uint64
+	poll         func(startBlock, endBlock uint64) error
+	// Cancelled when the poller is asked to stop, poll funcs should use it for all network calls.
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// newEventPoller creates a poller that will start polling from the current Ethereum block.
+func newEventPoller(
+	name string, rootChain RootChainClient, pollInterval time.Duration,
+	poll func(startBlock, endBlock uint64) error,
+) (*eventPoller, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	curBlock, err := rootChain.EthBlockNumber(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &eventPoller{
+		name:         name,
+		rootChain:    rootChain,
+		pollInterval: pollInterval,
+		nextBlock:    curBlock,
+		poll:         poll,
+		ctx:          ctx,
+		cancel:       cancel,
+		done:         make(chan struct{}),
+	}, nil
+}
+
+func (p *eventPoller) run() {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.pollOnce(); err != nil && p.ctx.Err() == nil {
+			log.Printf("%s failed to poll for events: %v", p.name, err)
+		}
+
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *eventPoller) pollOnce() error {
+	latestBlock, err := p.rootChain.EthBlockNumber(p.ctx)
+	if err != nil {
+		return err
+	}
+	if latestBlock < p.nextBlock {
+		return nil
+	}
+	if err := p.poll(p.nextBlock, latestBlock); err != nil {
+		return err
+	}
+	p.nextBlock = latestBlock + 1
+	return nil
+}
+
+// stopped returns a channel that's closed when the poller is asked to stop, poll funcs should
+// select on it to avoid blocking the shutdown of the poller.
+func (p *eventPoller) stopped() <-chan struct{} {
+	return p.ctx.Done()
+}
+
+// stop stops the poller, cancelling any network calls that are still in-flight.
+func (p *eventPoller) stop() {
+	p.cancel()
+	<-p.done
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// How often the exit watchers query the RootChain contract for new StartedExit events.
+const ExitWatcherPollInterval = 2 * time.Second
+
+// ExitEvent is emitted by an exit watcher whenever an exit is started for the slot it's watching.
+type ExitEvent struct {
+	Slot  uint64
+	Owner common.Address
+	// Plasma blocks referenced by the exit, these will be nil if the exit was no longer active by
+	// the time the event was processed.
+	PrevBlock *big.Int
+	ExitBlock *big.Int
+	// Ethereum block & tx in which the exit was started
+	EthBlockNumber uint64
+	EthTxHash      common.Hash
+}
+
+// exitWatcher polls the RootChain contract for exits of a single slot.
+type exitWatcher struct {
+	*eventPoller
+	slot   uint64
+	events chan *ExitEvent
+}
+
+// newExitWatcher creates a watcher that will report all the exits of the given slot that are
+// started from the current Ethereum block onwards.
+func newExitWatcher(rootChain RootChainClient, slot uint64, pollInterval time.Duration) (*exitWatcher, error) {
+	w := &exitWatcher{
+		slot:   slot,
+		events: make(chan *ExitEvent, 16),
+	}
+	poller, err := newEventPoller(fmt.Sprintf("exit watcher (slot %d)", slot), rootChain, pollInterval, w.poll)
+	if err != nil {
+		return nil, err
+	}
+	w.eventPoller = poller
+	return w, nil
+}
+
+func (w *exitWatcher) run() {
+	defer close(w.events)
+	w.eventPoller.run()
+}
+
+func (w *exitWatcher) poll(startBlock, endBlock uint64) error {
+	exits, err := w.rootChain.StartedExits(w.ctx, w.slot, startBlock, endBlock)
+	if err != nil {
+		return err
+	}
+	for _, exit := range exits {
+		select {
+		case w.events <- exit:
+		case <-w.stopped():
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type ChallengeType int
+
+const (
+	NoChallenge ChallengeType = iota
+	ChallengeAfterType
+	ChallengeBetweenType
+	ChallengeBeforeType
+)
+
+func (t ChallengeType) String() string {
+	switch t {
+	case NoChallenge:
+		return "none"
+	case ChallengeAfterType:
+		return "challengeAfter"
+	case ChallengeBetweenType:
+		return "challengeBetween"
+	case ChallengeBeforeType:
+		return "challengeBefore"
+	}
+	return fmt.Sprintf("ChallengeType(%d)", int(t))
+}
+
+// GuardianChallenge describes a challenge submitted by a Guardian in response to an invalid exit.
+type GuardianChallenge struct {
+	Exit *ExitEvent
+	Type ChallengeType
+	// Plasma block containing the tx used to challenge the exit
+	BlockNum *big.Int
+	// Hash of the Ethereum tx that submitted the challenge
+	TxHash []byte
+	Err    error
+}
+
+// Guardian watches the exits of the coins tracked by a client, and automatically challenges any
+// exit that conflicts with the valid history of a coin.
+type Guardian struct {
+	client *Client
+	// Cancelled when the guardian is stopped, to abort any challenges that are in-flight.
+	ctx        context.Context
+	cancel     context.CancelFunc
+	mutex      sync.Mutex
+	slots      map[uint64]chan struct{}
+	wg         sync.WaitGroup
+	challenges chan *GuardianChallenge
+}
+
+func NewGuardian(c *Client) *Guardian {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Guardian{
+		client:     c,
+		ctx:        ctx,
+		cancel:     cancel,
+		slots:      make(map[uint64]chan struct{}),
+		challenges: make(chan *GuardianChallenge, 16),
+	}
+}
+
+// Challenges returns a channel that receives the outcome of every challenge submitted by the
+// guardian. If the channel isn't drained outcomes will be dropped (but still logged).
+func (g *Guardian) Challenges() <-chan *GuardianChallenge {
+	return g.challenges
+}
+
+// Track starts guarding the coin at the given slot.
+func (g *Guardian) Track(slot uint64) error {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if _, exists := g.slots[slot]; exists {
+		return fmt.Errorf("slot %d is already being tracked", slot)
+	}
+	exits, err := g.client.WatchExits(slot)
+	if err != nil {
+		return err
+	}
+	done := make(chan struct{})
+	g.slots[slot] = done
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		defer close(done)
+		for exit := range exits {
+			g.handleExit(exit)
+		}
+	}()
+	return nil
+}
+
+// Untrack stops guarding the coin at the given slot.
+func (g *Guardian) Untrack(slot uint64) error {
+	g.mutex.Lock()
+	done, exists := g.slots[slot]
+	delete(g.slots, slot)
+	g.mutex.Unlock()
+
+	if !exists {
+		return fmt.Errorf("slot %d is not being tracked", slot)
+	}
+	if err := g.client.StopWatchingExits(slot); err != nil {
+		return err
+	}
+	<-done
+	return nil
+}
+
+// Stop stops guarding all the tracked coins, any challenges that are still in-flight are aborted.
+// The guardian can't be restarted once it's stopped.
+func (g *Guardian) Stop() {
+	g.cancel()
+
+	g.mutex.Lock()
+	slots := make([]uint64, 0, len(g.slots))
+	for slot := range g.slots {
+		slots = append(slots, slot)
+	}
+	g.mutex.Unlock()
+
+	for _, slot := range slots {
+		if err := g.Untrack(slot); err != nil {
+			log.Printf("guardian failed to untrack slot %d: %v", slot, err)
+		}
+	}
+	g.wg.Wait()
+}
+
+func (g *Guardian) handleExit(exit *ExitEvent) {
+	if exit.PrevBlock == nil || exit.ExitBlock == nil {
+		// exit is no longer active
+		return
+	}
+
+	account, err := g.client.TokenContract.Account()
+	if err != nil {
+		g.report(&GuardianChallenge{Exit: exit, Err: err})
+		return
+	}
+	self := common.HexToAddress(account.Address)
+	if exit.Owner == self {
+		return
+	}
+
+	history, err := g.client.CoinHistoryContext(g.ctx, exit.Slot)
+	if err != nil {
+		g.report(&GuardianChallenge{Exit: exit, Err: err})
+		return
+	}
+
+	challengeType, blockNum := chooseChallenge(exit, history, self)
+	result := &GuardianChallenge{Exit: exit, Type: challengeType, BlockNum: blockNum}
+	switch challengeType {
+	case NoChallenge:
+		return
+	case ChallengeAfterType:
+		result.TxHash, result.Err = g.client.ChallengeAfterContext(g.ctx, exit.Slot, blockNum)
+	case ChallengeBetweenType:
+		result.TxHash, result.Err = g.client.ChallengeBetweenContext(g.ctx, exit.Slot, blockNum)
+	case ChallengeBeforeType:
+		result.TxHash, result.Err = g.client.ChallengeBeforeContext(g.ctx, exit.Slot, blockNum)
+	}
+	g.report(result)
+}
+
+func (g *Guardian) report(result *GuardianChallenge) {
+	if result.Err != nil {
+		log.Printf("guardian failed to handle exit of slot %d: %v", result.Exit.Slot, result.Err)
+	} else {
+		log.Printf("guardian submitted %v of slot %d with tx from block %v",
+			result.Type, result.Exit.Slot, result.BlockNum)
+	}
+
+	select {
+	case g.challenges <- result:
+	default:
+	}
+}
+
+// chooseChallenge compares an exit with the valid history of the exiting coin, and determines how
+// the exit should be challenged. Returns the type of challenge that should be submitted, and the
+// Plasma block containing the tx that should be used in the challenge.
+func chooseChallenge(exit *ExitEvent, history []*CoinTx, self common.Address) (ChallengeType, *big.Int) {
+	if len(history) == 0 {
+		return NoChallenge, nil
+	}
+
+	var exitingTx *CoinTx
+	for _, tx := range history {
+		if tx.BlockNum.Cmp(exit.ExitBlock) == 0 {
+			exitingTx = tx
+			break
+		}
+	}
+
+	// The exiting tx has been spent by a later tx
+	if exitingTx != nil && exitingTx.Owner == exit.Owner {
+		for _, tx := range history {
+			if tx.BlockNum.Cmp(exit.ExitBlock) > 0 && !tx.IsDeposit() && tx.PrevBlock.Cmp(exit.ExitBlock) == 0 {
+				return ChallengeAfterType, tx.BlockNum
+			}
+		}
+		// the exit is consistent with the valid history of the coin
+		return NoChallenge, nil
+	}
+
+	// The parent of the exiting tx has been spent in a tx before the exiting tx (double spend)
+	for _, tx := range history {
+		if tx.BlockNum.Cmp(exit.PrevBlock) > 0 && tx.BlockNum.Cmp(exit.ExitBlock) < 0 &&
+			!tx.IsDeposit() && tx.PrevBlock.Cmp(exit.PrevBlock) == 0 {
+			return ChallengeBetweenType, tx.BlockNum
+		}
+	}
+
+	// The exit has an invalid history, it's only worth challenging if the coin belongs to us,
+	// the challenge is done with the latest valid tx that precedes the exit's parent tx.
+	if history[len(history)-1].Owner != self {
+		return NoChallenge, nil
+	}
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].BlockNum.Cmp(exit.PrevBlock) <= 0 {
+			return ChallengeBeforeType, history[i].BlockNum
+		}
+	}
+	return NoChallenge, nil
+}
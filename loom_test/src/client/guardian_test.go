@@ -0,0 +1,85 @@
+package client
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type GuardianTestSuite struct{}
+
+var _ = Suite(&GuardianTestSuite{})
+
+var (
+	guardianTestAlice = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	guardianTestBob   = common.HexToAddress("0x2222222222222222222222222222222222222222")
+	guardianTestEve   = common.HexToAddress("0x3333333333333333333333333333333333333333")
+)
+
+func testCoinTx(blockNum, prevBlock int64, owner common.Address) *CoinTx {
+	return &CoinTx{
+		BlockNum:     big.NewInt(blockNum),
+		Slot:         5,
+		PrevBlock:    big.NewInt(prevBlock),
+		Denomination: big.NewInt(1),
+		Owner:        owner,
+	}
+}
+
+func testExit(prevBlock, exitBlock int64, owner common.Address) *ExitEvent {
+	return &ExitEvent{
+		Slot:      5,
+		Owner:     owner,
+		PrevBlock: big.NewInt(prevBlock),
+		ExitBlock: big.NewInt(exitBlock),
+	}
+}
+
+func (s *GuardianTestSuite) TestChallengeAfter(c *C) {
+	// Alice deposits, sends to Bob, then tries to exit the deposit
+	history := []*CoinTx{
+		testCoinTx(1, 0, guardianTestAlice),
+		testCoinTx(1000, 1, guardianTestBob),
+	}
+	challenge, blockNum := chooseChallenge(testExit(0, 1, guardianTestAlice), history, guardianTestBob)
+	c.Assert(challenge, Equals, ChallengeAfterType)
+	c.Assert(blockNum.Int64(), Equals, int64(1000))
+}
+
+func (s *GuardianTestSuite) TestChallengeBetween(c *C) {
+	// Alice deposits, sends to Bob, then double spends the deposit to Eve who tries to exit
+	history := []*CoinTx{
+		testCoinTx(1, 0, guardianTestAlice),
+		testCoinTx(1000, 1, guardianTestBob),
+	}
+	challenge, blockNum := chooseChallenge(testExit(1, 2000, guardianTestEve), history, guardianTestBob)
+	c.Assert(challenge, Equals, ChallengeBetweenType)
+	c.Assert(blockNum.Int64(), Equals, int64(1000))
+}
+
+func (s *GuardianTestSuite) TestChallengeBefore(c *C) {
+	// Bob deposits, Eve forges a couple of transfers of Bob's coin and tries to exit
+	history := []*CoinTx{
+		testCoinTx(3, 0, guardianTestBob),
+	}
+	challenge, blockNum := chooseChallenge(testExit(1000, 2000, guardianTestEve), history, guardianTestBob)
+	c.Assert(challenge, Equals, ChallengeBeforeType)
+	c.Assert(blockNum.Int64(), Equals, int64(3))
+
+	// Not worth challenging if the coin doesn't belong to us
+	challenge, _ = chooseChallenge(testExit(1000, 2000, guardianTestEve), history, guardianTestAlice)
+	c.Assert(challenge, Equals, NoChallenge)
+}
+
+func (s *GuardianTestSuite) TestValidExit(c *C) {
+	history := []*CoinTx{
+		testCoinTx(1, 0, guardianTestAlice),
+		testCoinTx(1000, 1, guardianTestBob),
+	}
+	challenge, _ := chooseChallenge(testExit(1, 1000, guardianTestBob), history, guardianTestAlice)
+	c.Assert(challenge, Equals, NoChallenge)
+}
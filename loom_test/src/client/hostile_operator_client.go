@@ -0,0 +1,91 @@
+package client
+
+import (
+	"hostile_operator"
+	"log"
+
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/auth"
+	"github.com/loomnetwork/go-loom/client"
+)
+
+const (
+	HostileOperatorContractName = "hostileoperator"
+)
+
+// HostileOperatorClient configures the attacks carried out by the HostileOperator contract.
+type HostileOperatorClient struct {
+	contract *client.Contract
+	chainID  string
+	signer   auth.Signer
+}
+
+// SetMisbehavior replaces the misbehavior profile of the operator, pass an empty profile to turn
+// off all the optional attacks.
+func (h *HostileOperatorClient) SetMisbehavior(misbehavior *hostile_operator.Misbehavior) error {
+	_, err := h.contract.Call("SetMisbehavior", &hostile_operator.SetMisbehaviorRequest{
+		Misbehavior: misbehavior,
+	}, h.signer, nil)
+	return err
+}
+
+// Reset turns off all the optional attacks. The misbehavior profile of the operator outlives the
+// process that set it, so demos must reset the operator before exiting, even on failure, or any
+// demos that run afterwards would be affected. Reset is usually called while bailing out, so
+// errors are only logged.
+func (h *HostileOperatorClient) Reset() {
+	if err := h.SetMisbehavior(&hostile_operator.Misbehavior{}); err != nil {
+		log.Printf("Failed to reset operator misbehavior: %v", err)
+	}
+}
+
+// Fatal resets the operator, then logs the args and exits, like log.Fatal.
+func (h *HostileOperatorClient) Fatal(v ...interface{}) {
+	h.Reset()
+	log.Fatal(v...)
+}
+
+// Fatalf resets the operator, then logs the formatted message and exits, like log.Fatalf.
+func (h *HostileOperatorClient) Fatalf(format string, v ...interface{}) {
+	h.Reset()
+	log.Fatalf(format, v...)
+}
+
+// ExitIfError calls Fatal if err isn't nil.
+func (h *HostileOperatorClient) ExitIfError(err error) {
+	if err != nil {
+		h.Fatal(err)
+	}
+}
+
+func (h *HostileOperatorClient) Misbehavior() (*hostile_operator.Misbehavior, error) {
+	caller := loom.Address{
+		ChainID: h.chainID,
+		Local:   loom.LocalAddressFromPublicKey(h.signer.PublicKey()),
+	}
+	resp := hostile_operator.GetMisbehaviorResponse{}
+	_, err := h.contract.StaticCall("GetMisbehavior", &hostile_operator.GetMisbehaviorRequest{}, caller, &resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Misbehavior == nil {
+		return &hostile_operator.Misbehavior{}, nil
+	}
+	return resp.Misbehavior, nil
+}
+
+// NewHostileOperatorClient creates a client that will sign its DAppChain txs with the given signer.
+func NewHostileOperatorClient(signer auth.Signer, chainID, writeUri, readUri string) (*HostileOperatorClient, error) {
+	rpcClient := client.NewDAppChainRPCClient(chainID, writeUri, readUri)
+
+	contractAddr, err := rpcClient.Resolve(HostileOperatorContractName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HostileOperatorClient{
+		contract: client.NewContract(rpcClient, contractAddr.Local),
+		chainID:  chainID,
+		signer:   signer,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NonceSource returns the next nonce of an account, including any txs that are pending.
+type NonceSource interface {
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+}
+
+// NonceManager keeps track of the nonce of an Ethereum account locally, so that multiple txs can
+// be sent from the same account concurrently without waiting for each one to be mined.
+type NonceManager struct {
+	mutex  sync.Mutex
+	source NonceSource
+	addr   common.Address
+	nonce  uint64
+	synced bool
+}
+
+// NewNonceManager creates a NonceManager that fetches the initial nonce of the account from the
+// given source.
+func NewNonceManager(source NonceSource, addr common.Address) *NonceManager {
+	return &NonceManager{
+		source: source,
+		addr:   addr,
+	}
+}
+
+// Send calls the given function with the next nonce of the account. Sends are serialized so that
+// txs reach the Ethereum node in nonce order. If the function returns an error the nonce may or may
+// not have been used, so the nonce will be fetched from the source again before the next send.
+// If the Ethereum node rejected the nonce (e.g. because txs were sent from the account by other
+// means) the nonce is fetched again straight away, and the send is retried once.
+func (m *NonceManager) Send(ctx context.Context, send func(nonce uint64) error) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	err := m.send(ctx, send)
+	if err != nil && isNonceError(err) {
+		err = m.send(ctx, send)
+	}
+	return err
+}
+
+func (m *NonceManager) send(ctx context.Context, send func(nonce uint64) error) error {
+	if !m.synced {
+		nonce, err := m.source.PendingNonceAt(ctx, m.addr)
+		if err != nil {
+			return err
+		}
+		m.nonce = nonce
+		m.synced = true
+	}
+	if err := send(m.nonce); err != nil {
+		m.synced = false
+		return err
+	}
+	m.nonce++
+	return nil
+}
+
+// isNonceError returns true if the given error indicates the Ethereum node rejected a tx because
+// of its nonce.
+func isNonceError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "nonce too low") ||
+		strings.Contains(msg, "nonce too high") ||
+		// ganache
+		strings.Contains(msg, "correct nonce")
+}
+
+// Reset discards the locally tracked nonce, the nonce will be fetched from the source again before
+// the next send. This should be called if txs are sent from the account by other means.
+func (m *NonceManager) Reset() {
+	m.mutex.Lock()
+	m.synced = false
+	m.mutex.Unlock()
+}
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "gopkg.in/check.v1"
+)
+
+type NonceManagerTestSuite struct{}
+
+var _ = Suite(&NonceManagerTestSuite{})
+
+type testNonceSource struct {
+	nonce uint64
+	calls int
+}
+
+func (s *testNonceSource) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	s.calls++
+	return s.nonce, nil
+}
+
+func (s *NonceManagerTestSuite) TestConcurrentSends(c *C) {
+	source := &testNonceSource{nonce: 7}
+	m := NewNonceManager(source, common.HexToAddress("0x01"))
+
+	var wg sync.WaitGroup
+	var noncesMutex sync.Mutex
+	nonces := make(map[uint64]bool)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := m.Send(context.Background(), func(nonce uint64) error {
+				noncesMutex.Lock()
+				nonces[nonce] = true
+				noncesMutex.Unlock()
+				return nil
+			})
+			c.Check(err, IsNil)
+		}()
+	}
+	wg.Wait()
+
+	// Every send should've used a unique nonce, and the source should only be queried once
+	c.Assert(nonces, HasLen, 20)
+	for nonce := uint64(7); nonce < 27; nonce++ {
+		c.Assert(nonces[nonce], Equals, true)
+	}
+	c.Assert(source.calls, Equals, 1)
+}
+
+func (s *NonceManagerTestSuite) TestResyncAfterFailedSend(c *C) {
+	source := &testNonceSource{nonce: 3}
+	m := NewNonceManager(source, common.HexToAddress("0x01"))
+
+	var used uint64
+	c.Assert(m.Send(context.Background(), func(nonce uint64) error { used = nonce; return nil }), IsNil)
+	c.Assert(used, Equals, uint64(3))
+
+	sendErr := errors.New("connection refused")
+	c.Assert(m.Send(context.Background(), func(nonce uint64) error { return sendErr }), Equals, sendErr)
+
+	// The nonce should be fetched from the source again after a failed send
+	source.nonce = 10
+	c.Assert(m.Send(context.Background(), func(nonce uint64) error { used = nonce; return nil }), IsNil)
+	c.Assert(used, Equals, uint64(10))
+	c.Assert(source.calls, Equals, 2)
+}
+
+func (s *NonceManagerTestSuite) TestRetryOnNonceError(c *C) {
+	source := &testNonceSource{nonce: 3}
+	m := NewNonceManager(source, common.HexToAddress("0x01"))
+	c.Assert(m.Send(context.Background(), func(nonce uint64) error { return nil }), IsNil)
+
+	// Another tx was sent from the account behind the nonce manager's back
+	source.nonce = 5
+	var used []uint64
+	err := m.Send(context.Background(), func(nonce uint64) error {
+		used = append(used, nonce)
+		if nonce != source.nonce {
+			return errors.New("nonce too low")
+		}
+		return nil
+	})
+	c.Assert(err, IsNil)
+	c.Assert(used, DeepEquals, []uint64{4, 5})
+	c.Assert(source.calls, Equals, 2)
+
+	// Only one retry is attempted
+	sendErr := errors.New("nonce too high")
+	attempts := 0
+	err = m.Send(context.Background(), func(nonce uint64) error { attempts++; return sendErr })
+	c.Assert(err, Equals, sendErr)
+	c.Assert(attempts, Equals, 2)
+}
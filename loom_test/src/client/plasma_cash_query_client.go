@@ -0,0 +1,39 @@
+package client
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	loom "github.com/loomnetwork/go-loom"
+	pctypes "github.com/loomnetwork/go-loom/builtin/types/plasma_cash"
+	"github.com/loomnetwork/go-loom/client"
+)
+
+// PlasmaCashQueryClient queries the DAppChain Plasma Cash contract for data that isn't exposed by
+// plasma_cash.ChainServiceClient.
+type PlasmaCashQueryClient struct {
+	contract *client.Contract
+}
+
+// UserSlots returns the slots of the coins owned by the given Ethereum account on the DAppChain.
+func (p *PlasmaCashQueryClient) UserSlots(owner common.Address) ([]uint64, error) {
+	// The DAppChain keeps track of coins by the Ethereum address of their owner
+	ownerAddr := loom.Address{ChainID: "eth", Local: owner.Bytes()}
+	resp := pctypes.GetUserSlotsResponse{}
+	_, err := p.contract.StaticCall("GetUserSlotsRequest", &pctypes.GetUserSlotsRequest{
+		From: ownerAddr.MarshalPB(),
+	}, ownerAddr, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Slots, nil
+}
+
+func NewPlasmaCashQueryClient(contractName, chainID, writeUri, readUri string) (*PlasmaCashQueryClient, error) {
+	rpcClient := client.NewDAppChainRPCClient(chainID, writeUri, readUri)
+
+	contractAddr, err := rpcClient.Resolve(contractName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PlasmaCashQueryClient{contract: client.NewContract(rpcClient, contractAddr.Local)}, nil
+}
@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/loomnetwork/go-loom/client/plasma_cash"
+)
+
+// Coin states, as declared in RootChain.sol.
+const (
+	coinStateNotExiting plasma_cash.PlasmaCoinState = iota
+	coinStateExiting
+	coinStateExited
+)
+
+// UserSlotsReader returns the slots of the coins owned by an Ethereum account on the DAppChain.
+type UserSlotsReader interface {
+	UserSlots(owner common.Address) ([]uint64, error)
+}
+
+// PortfolioCoin is a coin owned by the client, along with its state on the RootChain.
+type PortfolioCoin struct {
+	Slot uint64
+	Coin *plasma_cash.PlasmaCoin
+	// Number of challenges of the coin's exit that haven't been responded to yet
+	PendingChallenges int
+}
+
+// Portfolio summarizes the assets of a client on Ethereum and the DAppChain.
+type Portfolio struct {
+	Owner common.Address
+	// Tokens owned by the client in the token contract, i.e. tokens that haven't been deposited
+	TokensOnEthereum *big.Int
+	// Deposited coins that aren't being exited
+	Deposited []*PortfolioCoin
+	// Coins with an exit in progress that hasn't been challenged
+	Exiting []*PortfolioCoin
+	// Coins with an exit in progress that has challenges pending a response
+	Challenged []*PortfolioCoin
+	// Coins that have been exited, but haven't been withdrawn yet
+	Exited []*PortfolioCoin
+	// Bonds held by the RootChain contract, including bonds that can be withdrawn
+	Bonds *BondBalance
+}
+
+// SetUserSlotsReader sets the source of the slots owned by the client on the DAppChain, the slots
+// are combined with the coins in the client's coin store.
+func (c *Client) SetUserSlotsReader(reader UserSlotsReader) {
+	c.userSlots = reader
+}
+
+func (c *Client) Portfolio() (*Portfolio, error) {
+	return c.PortfolioContext(context.Background())
+}
+
+// PortfolioContext combines the token balance of the client on Ethereum, the coins the client
+// owns on the DAppChain, the state of each of those coins on the RootChain, and the client's
+// bonds into a single report.
+func (c *Client) PortfolioContext(ctx context.Context) (*Portfolio, error) {
+	account, err := c.TokenContract.Account()
+	if err != nil {
+		return nil, err
+	}
+	owner := common.HexToAddress(account.Address)
+	portfolio := &Portfolio{Owner: owner}
+
+	portfolio.TokensOnEthereum, err = c.TokenContract.BalanceOfContext(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve token balance")
+	}
+	portfolio.Bonds, err = c.RootChain.Bonds(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	slots, err := c.portfolioSlots(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, slot := range slots {
+		coin, err := c.RootChain.PlasmaCoinContext(ctx, slot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to retrieve coin at slot %d", slot)
+		}
+		// Withdrawn coins are removed from the RootChain
+		if coin.DepositBlockNum == nil || coin.DepositBlockNum.Sign() == 0 {
+			continue
+		}
+		entry := &PortfolioCoin{Slot: slot, Coin: coin}
+		switch coin.State {
+		case coinStateNotExiting:
+			portfolio.Deposited = append(portfolio.Deposited, entry)
+		case coinStateExiting:
+			// Someone else may be exiting a coin the client used to own
+			exit, err := c.RootChain.Exit(ctx, slot)
+			if err != nil {
+				return nil, err
+			}
+			if exit.Owner != owner {
+				continue
+			}
+			challenges, err := c.RootChain.PendingChallenges(ctx, slot)
+			if err != nil {
+				return nil, err
+			}
+			entry.PendingChallenges = len(challenges)
+			if entry.PendingChallenges > 0 {
+				portfolio.Challenged = append(portfolio.Challenged, entry)
+			} else {
+				portfolio.Exiting = append(portfolio.Exiting, entry)
+			}
+		case coinStateExited:
+			// Exited coins can only be withdrawn by the owner of the exit
+			if common.HexToAddress(coin.Owner) != owner {
+				continue
+			}
+			portfolio.Exited = append(portfolio.Exited, entry)
+		}
+	}
+	return portfolio, nil
+}
+
+// portfolioSlots returns the slots the operator reports for the given owner, along with the slots
+// in the client's coin store, which include coins received from others.
+func (c *Client) portfolioSlots(owner common.Address) ([]uint64, error) {
+	slots, err := c.store.Slots()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve coin slots from the coin store")
+	}
+	if c.userSlots == nil {
+		return slots, nil
+	}
+	userSlots, err := c.userSlots.UserSlots(owner)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve coin slots from the operator")
+	}
+	seen := make(map[uint64]bool, len(slots))
+	for _, slot := range slots {
+		seen[slot] = true
+	}
+	for _, slot := range userSlots {
+		if !seen[slot] {
+			seen[slot] = true
+			slots = append(slots, slot)
+		}
+	}
+	return slots, nil
+}
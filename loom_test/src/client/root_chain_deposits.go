@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"ethcontract"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// DepositETHTx sends a tx that deposits the given amount of ETH (in wei) into the RootChain
+// contract, and returns the tx hash.
+func (d *RootChainService) DepositETHTx(ctx context.Context, amount *big.Int) (common.Hash, error) {
+	return d.transactor.transact(&txOptions{Context: ctx, Value: amount}, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		// ETH deposits are plain transfers to the contract, handled by its fallback function
+		raw := &ethcontract.RootChainTransactorRaw{Contract: &d.plasmaContract.RootChainTransactor}
+		return raw.Transfer(opts)
+	})
+}
+
+// DepositERC20Tx approves the transfer of the given amount of ERC20 tokens to the RootChain
+// contract (unless it's already been approved), then sends a tx that deposits the tokens, and
+// returns the hash of the deposit tx.
+func (d *RootChainService) DepositERC20Tx(ctx context.Context, tokenAddr common.Address, amount *big.Int) (common.Hash, error) {
+	token, err := ethcontract.NewERC20(tokenAddr, d.backend)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	allowance, err := token.Allowance(d.callOpts(ctx), d.callerAddr, d.contractAddr)
+	if err != nil {
+		return common.Hash{}, errors.Wrapf(err, "failed to query ERC20 allowance of %s", d.callerAddr.Hex())
+	}
+	if allowance.Cmp(amount) < 0 {
+		err := d.approveAndWait(ctx, tokenAddr, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return token.Approve(opts, d.contractAddr, amount)
+		})
+		if err != nil {
+			return common.Hash{}, err
+		}
+	}
+	return d.transactor.transact(&txOptions{Context: ctx}, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return d.plasmaContract.DepositERC20(opts, amount, tokenAddr)
+	})
+}
+
+// DepositERC721Tx approves the transfer of the given ERC721 token to the RootChain contract
+// (unless it's already been approved), then sends a tx that deposits the token, and returns the
+// hash of the deposit tx.
+func (d *RootChainService) DepositERC721Tx(ctx context.Context, tokenAddr common.Address, uid *big.Int) (common.Hash, error) {
+	token, err := ethcontract.NewERC721(tokenAddr, d.backend)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	approved, err := token.GetApproved(d.callOpts(ctx), uid)
+	if err != nil {
+		return common.Hash{}, errors.Wrapf(err, "failed to query approval of ERC721 token %v", uid)
+	}
+	if approved != d.contractAddr {
+		err := d.approveAndWait(ctx, tokenAddr, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return token.Approve(opts, d.contractAddr, uid)
+		})
+		if err != nil {
+			return common.Hash{}, err
+		}
+	}
+	return d.transactor.transact(&txOptions{Context: ctx}, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return d.plasmaContract.DepositERC721(opts, uid, tokenAddr)
+	})
+}
+
+// approveAndWait sends the approve tx of the given token contract, and waits for the approval to be
+// mined. The deposit can't be sent before then because gas estimation for the deposit would fail.
+func (d *RootChainService) approveAndWait(
+	ctx context.Context, tokenAddr common.Address, approve func(*bind.TransactOpts) (*types.Transaction, error),
+) error {
+	txHash, err := d.transactor.transact(&txOptions{Context: ctx}, approve)
+	if err != nil {
+		return errors.Wrapf(err, "failed to approve deposit of tokens from %s", tokenAddr.Hex())
+	}
+	if _, err := waitMined(ctx, d.backend, d.contractAddr, txHash); err != nil {
+		return errors.Wrapf(err, "failed to approve deposit of tokens from %s", tokenAddr.Hex())
+	}
+	return nil
+}
@@ -0,0 +1,528 @@
+package client
+
+import (
+	"context"
+	"ethcontract"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	rootChainABI = mustParseABI(ethcontract.RootChainABI)
+	// Only used to decode RootChain event logs, so it's not bound to a contract or backend.
+	rootChainEventUnpacker = bind.NewBoundContract(common.Address{}, rootChainABI, nil, nil, nil)
+)
+
+func mustParseABI(abiJSON string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// RootChainEventFilterer retrieves the RootChain events emitted within a range of Ethereum blocks.
+// The indexed params of each event can be used to narrow down the results, an empty filter
+// matches any value.
+type RootChainEventFilterer interface {
+	FilterDepositEvents(opts *bind.FilterOpts, slot []uint64, from []common.Address, contractAddress []common.Address) ([]*RootChainDeposit, error)
+	FilterSubmittedBlockEvents(opts *bind.FilterOpts) ([]*RootChainSubmittedBlock, error)
+	FilterStartedExitEvents(opts *bind.FilterOpts, slot []uint64, owner []common.Address) ([]*RootChainStartedExit, error)
+	FilterChallengedExitEvents(opts *bind.FilterOpts, slot []uint64) ([]*RootChainChallengedExit, error)
+	FilterRespondedExitChallengeEvents(opts *bind.FilterOpts, slot []uint64) ([]*RootChainRespondedExitChallenge, error)
+	FilterCoinResetEvents(opts *bind.FilterOpts, slot []uint64, owner []common.Address) ([]*RootChainCoinReset, error)
+	FilterFinalizedExitEvents(opts *bind.FilterOpts, slot []uint64) ([]*RootChainFinalizedExit, error)
+	FilterFreedBondEvents(opts *bind.FilterOpts, from []common.Address) ([]*RootChainFreedBond, error)
+	FilterSlashedBondEvents(opts *bind.FilterOpts, from []common.Address, to []common.Address) ([]*RootChainSlashedBond, error)
+	FilterWithdrewBondsEvents(opts *bind.FilterOpts, from []common.Address) ([]*RootChainWithdrewBonds, error)
+	FilterWithdrewEvents(opts *bind.FilterOpts, owner []common.Address, slot []uint64) ([]*RootChainWithdrew, error)
+	FilterPausedEvents(opts *bind.FilterOpts) ([]*RootChainPaused, error)
+}
+
+// Decode unpacks the event into out, which should be one of the RootChain* event types that
+// matches the name of the event.
+func (e *RootChainEvent) Decode(out interface{}) error {
+	return unpackRootChainEvent(out, e.Name, *e.Log)
+}
+
+func unpackRootChainEvent(out interface{}, name string, log types.Log) error {
+	if err := rootChainEventUnpacker.UnpackLog(out, name, log); err != nil {
+		return errors.Wrapf(err, "failed to decode %s event", name)
+	}
+	return nil
+}
+
+// eventLogs returns the logs of all the events with the given name emitted by the tx.
+func (r *TxReceipt) eventLogs(name string) []types.Log {
+	var logs []types.Log
+	for _, event := range r.Events {
+		if event.Name == name {
+			logs = append(logs, *event.Log)
+		}
+	}
+	return logs
+}
+
+// filterRootChainLogs retrieves the logs of the given RootChain event, topics should contain the
+// values each of the indexed params of the event must match.
+func (d *RootChainService) filterRootChainLogs(
+	opts *bind.FilterOpts, name string, topics ...[]common.Hash,
+) ([]types.Log, error) {
+	if opts == nil {
+		opts = &bind.FilterOpts{}
+	}
+	query := ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(opts.Start),
+		Addresses: []common.Address{d.contractAddr},
+		Topics:    append([][]common.Hash{{rootChainABI.Events[name].Id()}}, topics...),
+	}
+	if opts.End != nil {
+		query.ToBlock = new(big.Int).SetUint64(*opts.End)
+	}
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	logs, err := d.backend.FilterLogs(ctx, query)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve %s events", name)
+	}
+	return logs, nil
+}
+
+func slotTopics(slots []uint64) []common.Hash {
+	topics := make([]common.Hash, len(slots))
+	for i, slot := range slots {
+		topics[i] = common.BigToHash(new(big.Int).SetUint64(slot))
+	}
+	return topics
+}
+
+func addressTopics(addrs []common.Address) []common.Hash {
+	topics := make([]common.Hash, len(addrs))
+	for i, addr := range addrs {
+		topics[i] = addr.Hash()
+	}
+	return topics
+}
+
+// RootChainDeposit is emitted when a coin is deposited into the RootChain contract.
+type RootChainDeposit struct {
+	Slot uint64
+	// Plasma block containing the deposit tx
+	BlockNumber  *big.Int
+	Denomination *big.Int
+	From         common.Address
+	// Token contract the coin was deposited from
+	ContractAddress common.Address
+	// Log the event was decoded from
+	Raw types.Log
+}
+
+// DepositEvents decodes the Deposit events emitted by the tx.
+func (r *TxReceipt) DepositEvents() ([]*RootChainDeposit, error) {
+	return decodeDepositLogs(r.eventLogs("Deposit"))
+}
+
+// FilterDepositEvents retrieves the Deposit events emitted within the range of blocks in opts.
+func (d *RootChainService) FilterDepositEvents(opts *bind.FilterOpts, slot []uint64, from []common.Address, contractAddress []common.Address) ([]*RootChainDeposit, error) {
+	logs, err := d.filterRootChainLogs(opts, "Deposit", slotTopics(slot), addressTopics(from), addressTopics(contractAddress))
+	if err != nil {
+		return nil, err
+	}
+	return decodeDepositLogs(logs)
+}
+
+func decodeDepositLogs(logs []types.Log) ([]*RootChainDeposit, error) {
+	events := make([]*RootChainDeposit, len(logs))
+	for i, log := range logs {
+		events[i] = &RootChainDeposit{Raw: log}
+		if err := unpackRootChainEvent(events[i], "Deposit", log); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+// RootChainSubmittedBlock is emitted when the Plasma operator submits a new Plasma block.
+type RootChainSubmittedBlock struct {
+	BlockNumber *big.Int
+	// Root of the sparse merkle tree containing the txs in the block
+	Root      [32]byte
+	Timestamp *big.Int
+	// Log the event was decoded from
+	Raw types.Log
+}
+
+// SubmittedBlockEvents decodes the SubmittedBlock events emitted by the tx.
+func (r *TxReceipt) SubmittedBlockEvents() ([]*RootChainSubmittedBlock, error) {
+	return decodeSubmittedBlockLogs(r.eventLogs("SubmittedBlock"))
+}
+
+// FilterSubmittedBlockEvents retrieves the SubmittedBlock events emitted within the range of blocks in opts.
+func (d *RootChainService) FilterSubmittedBlockEvents(opts *bind.FilterOpts) ([]*RootChainSubmittedBlock, error) {
+	logs, err := d.filterRootChainLogs(opts, "SubmittedBlock")
+	if err != nil {
+		return nil, err
+	}
+	return decodeSubmittedBlockLogs(logs)
+}
+
+func decodeSubmittedBlockLogs(logs []types.Log) ([]*RootChainSubmittedBlock, error) {
+	events := make([]*RootChainSubmittedBlock, len(logs))
+	for i, log := range logs {
+		events[i] = &RootChainSubmittedBlock{Raw: log}
+		if err := unpackRootChainEvent(events[i], "SubmittedBlock", log); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+// RootChainStartedExit is emitted when an exit is started.
+type RootChainStartedExit struct {
+	Slot  uint64
+	Owner common.Address
+	// Log the event was decoded from
+	Raw types.Log
+}
+
+// StartedExitEvents decodes the StartedExit events emitted by the tx.
+func (r *TxReceipt) StartedExitEvents() ([]*RootChainStartedExit, error) {
+	return decodeStartedExitLogs(r.eventLogs("StartedExit"))
+}
+
+// FilterStartedExitEvents retrieves the StartedExit events emitted within the range of blocks in opts.
+func (d *RootChainService) FilterStartedExitEvents(opts *bind.FilterOpts, slot []uint64, owner []common.Address) ([]*RootChainStartedExit, error) {
+	logs, err := d.filterRootChainLogs(opts, "StartedExit", slotTopics(slot), addressTopics(owner))
+	if err != nil {
+		return nil, err
+	}
+	return decodeStartedExitLogs(logs)
+}
+
+func decodeStartedExitLogs(logs []types.Log) ([]*RootChainStartedExit, error) {
+	events := make([]*RootChainStartedExit, len(logs))
+	for i, log := range logs {
+		events[i] = &RootChainStartedExit{Raw: log}
+		if err := unpackRootChainEvent(events[i], "StartedExit", log); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+// RootChainChallengedExit is emitted when an exit is challenged with challengeBefore.
+type RootChainChallengedExit struct {
+	Slot uint64
+	// Hash of the Plasma tx used to challenge the exit
+	TxHash                 [32]byte
+	ChallengingBlockNumber *big.Int
+	// Log the event was decoded from
+	Raw types.Log
+}
+
+// ChallengedExitEvents decodes the ChallengedExit events emitted by the tx.
+func (r *TxReceipt) ChallengedExitEvents() ([]*RootChainChallengedExit, error) {
+	return decodeChallengedExitLogs(r.eventLogs("ChallengedExit"))
+}
+
+// FilterChallengedExitEvents retrieves the ChallengedExit events emitted within the range of blocks in opts.
+func (d *RootChainService) FilterChallengedExitEvents(opts *bind.FilterOpts, slot []uint64) ([]*RootChainChallengedExit, error) {
+	logs, err := d.filterRootChainLogs(opts, "ChallengedExit", slotTopics(slot))
+	if err != nil {
+		return nil, err
+	}
+	return decodeChallengedExitLogs(logs)
+}
+
+func decodeChallengedExitLogs(logs []types.Log) ([]*RootChainChallengedExit, error) {
+	events := make([]*RootChainChallengedExit, len(logs))
+	for i, log := range logs {
+		events[i] = &RootChainChallengedExit{Raw: log}
+		if err := unpackRootChainEvent(events[i], "ChallengedExit", log); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+// RootChainRespondedExitChallenge is emitted when a challengeBefore challenge is responded to.
+type RootChainRespondedExitChallenge struct {
+	Slot uint64
+	// Log the event was decoded from
+	Raw types.Log
+}
+
+// RespondedExitChallengeEvents decodes the RespondedExitChallenge events emitted by the tx.
+func (r *TxReceipt) RespondedExitChallengeEvents() ([]*RootChainRespondedExitChallenge, error) {
+	return decodeRespondedExitChallengeLogs(r.eventLogs("RespondedExitChallenge"))
+}
+
+// FilterRespondedExitChallengeEvents retrieves the RespondedExitChallenge events emitted within the range of blocks in opts.
+func (d *RootChainService) FilterRespondedExitChallengeEvents(opts *bind.FilterOpts, slot []uint64) ([]*RootChainRespondedExitChallenge, error) {
+	logs, err := d.filterRootChainLogs(opts, "RespondedExitChallenge", slotTopics(slot))
+	if err != nil {
+		return nil, err
+	}
+	return decodeRespondedExitChallengeLogs(logs)
+}
+
+func decodeRespondedExitChallengeLogs(logs []types.Log) ([]*RootChainRespondedExitChallenge, error) {
+	events := make([]*RootChainRespondedExitChallenge, len(logs))
+	for i, log := range logs {
+		events[i] = &RootChainRespondedExitChallenge{Raw: log}
+		if err := unpackRootChainEvent(events[i], "RespondedExitChallenge", log); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+// RootChainCoinReset is emitted when an exit is successfully challenged, or cancelled.
+type RootChainCoinReset struct {
+	Slot  uint64
+	Owner common.Address
+	// Log the event was decoded from
+	Raw types.Log
+}
+
+// CoinResetEvents decodes the CoinReset events emitted by the tx.
+func (r *TxReceipt) CoinResetEvents() ([]*RootChainCoinReset, error) {
+	return decodeCoinResetLogs(r.eventLogs("CoinReset"))
+}
+
+// FilterCoinResetEvents retrieves the CoinReset events emitted within the range of blocks in opts.
+func (d *RootChainService) FilterCoinResetEvents(opts *bind.FilterOpts, slot []uint64, owner []common.Address) ([]*RootChainCoinReset, error) {
+	logs, err := d.filterRootChainLogs(opts, "CoinReset", slotTopics(slot), addressTopics(owner))
+	if err != nil {
+		return nil, err
+	}
+	return decodeCoinResetLogs(logs)
+}
+
+func decodeCoinResetLogs(logs []types.Log) ([]*RootChainCoinReset, error) {
+	events := make([]*RootChainCoinReset, len(logs))
+	for i, log := range logs {
+		events[i] = &RootChainCoinReset{Raw: log}
+		if err := unpackRootChainEvent(events[i], "CoinReset", log); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+// RootChainFinalizedExit is emitted when an exit is finalized.
+type RootChainFinalizedExit struct {
+	Slot  uint64
+	Owner common.Address
+	// Log the event was decoded from
+	Raw types.Log
+}
+
+// FinalizedExitEvents decodes the FinalizedExit events emitted by the tx.
+func (r *TxReceipt) FinalizedExitEvents() ([]*RootChainFinalizedExit, error) {
+	return decodeFinalizedExitLogs(r.eventLogs("FinalizedExit"))
+}
+
+// FilterFinalizedExitEvents retrieves the FinalizedExit events emitted within the range of blocks in opts.
+func (d *RootChainService) FilterFinalizedExitEvents(opts *bind.FilterOpts, slot []uint64) ([]*RootChainFinalizedExit, error) {
+	logs, err := d.filterRootChainLogs(opts, "FinalizedExit", slotTopics(slot))
+	if err != nil {
+		return nil, err
+	}
+	return decodeFinalizedExitLogs(logs)
+}
+
+func decodeFinalizedExitLogs(logs []types.Log) ([]*RootChainFinalizedExit, error) {
+	events := make([]*RootChainFinalizedExit, len(logs))
+	for i, log := range logs {
+		events[i] = &RootChainFinalizedExit{Raw: log}
+		if err := unpackRootChainEvent(events[i], "FinalizedExit", log); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+// RootChainFreedBond is emitted when the bond of an exit or a challenge is freed.
+type RootChainFreedBond struct {
+	From   common.Address
+	Amount *big.Int
+	// Log the event was decoded from
+	Raw types.Log
+}
+
+// FreedBondEvents decodes the FreedBond events emitted by the tx.
+func (r *TxReceipt) FreedBondEvents() ([]*RootChainFreedBond, error) {
+	return decodeFreedBondLogs(r.eventLogs("FreedBond"))
+}
+
+// FilterFreedBondEvents retrieves the FreedBond events emitted within the range of blocks in opts.
+func (d *RootChainService) FilterFreedBondEvents(opts *bind.FilterOpts, from []common.Address) ([]*RootChainFreedBond, error) {
+	logs, err := d.filterRootChainLogs(opts, "FreedBond", addressTopics(from))
+	if err != nil {
+		return nil, err
+	}
+	return decodeFreedBondLogs(logs)
+}
+
+func decodeFreedBondLogs(logs []types.Log) ([]*RootChainFreedBond, error) {
+	events := make([]*RootChainFreedBond, len(logs))
+	for i, log := range logs {
+		events[i] = &RootChainFreedBond{Raw: log}
+		if err := unpackRootChainEvent(events[i], "FreedBond", log); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+// RootChainSlashedBond is emitted when the bond of an exit or a challenge is slashed.
+type RootChainSlashedBond struct {
+	// Account that forfeited the bond
+	From common.Address
+	// Account that received the bond
+	To     common.Address
+	Amount *big.Int
+	// Log the event was decoded from
+	Raw types.Log
+}
+
+// SlashedBondEvents decodes the SlashedBond events emitted by the tx.
+func (r *TxReceipt) SlashedBondEvents() ([]*RootChainSlashedBond, error) {
+	return decodeSlashedBondLogs(r.eventLogs("SlashedBond"))
+}
+
+// FilterSlashedBondEvents retrieves the SlashedBond events emitted within the range of blocks in opts.
+func (d *RootChainService) FilterSlashedBondEvents(opts *bind.FilterOpts, from []common.Address, to []common.Address) ([]*RootChainSlashedBond, error) {
+	logs, err := d.filterRootChainLogs(opts, "SlashedBond", addressTopics(from), addressTopics(to))
+	if err != nil {
+		return nil, err
+	}
+	return decodeSlashedBondLogs(logs)
+}
+
+func decodeSlashedBondLogs(logs []types.Log) ([]*RootChainSlashedBond, error) {
+	events := make([]*RootChainSlashedBond, len(logs))
+	for i, log := range logs {
+		events[i] = &RootChainSlashedBond{Raw: log}
+		if err := unpackRootChainEvent(events[i], "SlashedBond", log); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+// RootChainWithdrewBonds is emitted when an account withdraws its freed bonds.
+type RootChainWithdrewBonds struct {
+	From   common.Address
+	Amount *big.Int
+	// Log the event was decoded from
+	Raw types.Log
+}
+
+// WithdrewBondsEvents decodes the WithdrewBonds events emitted by the tx.
+func (r *TxReceipt) WithdrewBondsEvents() ([]*RootChainWithdrewBonds, error) {
+	return decodeWithdrewBondsLogs(r.eventLogs("WithdrewBonds"))
+}
+
+// FilterWithdrewBondsEvents retrieves the WithdrewBonds events emitted within the range of blocks in opts.
+func (d *RootChainService) FilterWithdrewBondsEvents(opts *bind.FilterOpts, from []common.Address) ([]*RootChainWithdrewBonds, error) {
+	logs, err := d.filterRootChainLogs(opts, "WithdrewBonds", addressTopics(from))
+	if err != nil {
+		return nil, err
+	}
+	return decodeWithdrewBondsLogs(logs)
+}
+
+func decodeWithdrewBondsLogs(logs []types.Log) ([]*RootChainWithdrewBonds, error) {
+	events := make([]*RootChainWithdrewBonds, len(logs))
+	for i, log := range logs {
+		events[i] = &RootChainWithdrewBonds{Raw: log}
+		if err := unpackRootChainEvent(events[i], "WithdrewBonds", log); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+// RootChainWithdrew is emitted when a coin is withdrawn from the RootChain contract.
+type RootChainWithdrew struct {
+	Owner common.Address
+	Slot  uint64
+	// Mode of the coin, see plasma_cash.PlasmaCoinMode
+	Mode uint8
+	// Token contract the coin was withdrawn to
+	ContractAddress common.Address
+	// UID of an ERC721 token, zero for other coin modes
+	Uid          *big.Int
+	Denomination *big.Int
+	// Log the event was decoded from
+	Raw types.Log
+}
+
+// WithdrewEvents decodes the Withdrew events emitted by the tx.
+func (r *TxReceipt) WithdrewEvents() ([]*RootChainWithdrew, error) {
+	return decodeWithdrewLogs(r.eventLogs("Withdrew"))
+}
+
+// FilterWithdrewEvents retrieves the Withdrew events emitted within the range of blocks in opts.
+func (d *RootChainService) FilterWithdrewEvents(opts *bind.FilterOpts, owner []common.Address, slot []uint64) ([]*RootChainWithdrew, error) {
+	logs, err := d.filterRootChainLogs(opts, "Withdrew", addressTopics(owner), slotTopics(slot))
+	if err != nil {
+		return nil, err
+	}
+	return decodeWithdrewLogs(logs)
+}
+
+func decodeWithdrewLogs(logs []types.Log) ([]*RootChainWithdrew, error) {
+	events := make([]*RootChainWithdrew, len(logs))
+	for i, log := range logs {
+		events[i] = &RootChainWithdrew{Raw: log}
+		if err := unpackRootChainEvent(events[i], "Withdrew", log); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+// RootChainPaused is emitted when deposits are paused or unpaused.
+type RootChainPaused struct {
+	// Set to true if deposits were paused
+	Status bool
+	// Log the event was decoded from
+	Raw types.Log
+}
+
+// PausedEvents decodes the Paused events emitted by the tx.
+func (r *TxReceipt) PausedEvents() ([]*RootChainPaused, error) {
+	return decodePausedLogs(r.eventLogs("Paused"))
+}
+
+// FilterPausedEvents retrieves the Paused events emitted within the range of blocks in opts.
+func (d *RootChainService) FilterPausedEvents(opts *bind.FilterOpts) ([]*RootChainPaused, error) {
+	logs, err := d.filterRootChainLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return decodePausedLogs(logs)
+}
+
+func decodePausedLogs(logs []types.Log) ([]*RootChainPaused, error) {
+	events := make([]*RootChainPaused, len(logs))
+	for i, log := range logs {
+		events[i] = &RootChainPaused{Raw: log}
+		if err := unpackRootChainEvent(events[i], "Paused", log); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
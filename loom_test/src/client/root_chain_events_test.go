@@ -0,0 +1,66 @@
+package client
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "gopkg.in/check.v1"
+)
+
+type RootChainEventsTestSuite struct{}
+
+var _ = Suite(&RootChainEventsTestSuite{})
+
+func (s *RootChainEventsTestSuite) TestDecodeReceiptEvents(c *C) {
+	rootChainAddr := common.HexToAddress("0x9e51aeeeca736cd81d27e025465834b8ec08628a")
+	owner := common.HexToAddress("0x1aa76056924bf4768d63357eca6d6a56ec929131")
+	tokenAddr := common.HexToAddress("0x2bb76056924bf4768d63357eca6d6a56ec929131")
+	withdrew := rootChainABI.Events["Withdrew"]
+	withdrewData, err := withdrew.Inputs.NonIndexed().Pack(uint8(2), tokenAddr, big.NewInt(5), big.NewInt(1))
+	c.Assert(err, IsNil)
+
+	receipt, err := newTxReceipt(rootChainAddr, common.HexToHash("0x01"), &types.Receipt{
+		Status: types.ReceiptStatusSuccessful,
+		Logs: []*types.Log{
+			{
+				Address: rootChainAddr,
+				Topics: []common.Hash{
+					rootChainABI.Events["StartedExit"].Id(), slotTopics([]uint64{3})[0], owner.Hash(),
+				},
+			},
+			{
+				Address: rootChainAddr,
+				Topics:  []common.Hash{withdrew.Id(), owner.Hash(), slotTopics([]uint64{7})[0]},
+				Data:    withdrewData,
+			},
+		},
+	})
+	c.Assert(err, IsNil)
+
+	exits, err := receipt.StartedExitEvents()
+	c.Assert(err, IsNil)
+	c.Assert(exits, HasLen, 1)
+	c.Assert(exits[0].Slot, Equals, uint64(3))
+	c.Assert(exits[0].Owner, Equals, owner)
+
+	withdrawals, err := receipt.WithdrewEvents()
+	c.Assert(err, IsNil)
+	c.Assert(withdrawals, HasLen, 1)
+	c.Assert(withdrawals[0].Owner, Equals, owner)
+	c.Assert(withdrawals[0].Slot, Equals, uint64(7))
+	c.Assert(withdrawals[0].Mode, Equals, uint8(2))
+	c.Assert(withdrawals[0].ContractAddress, Equals, tokenAddr)
+	c.Assert(withdrawals[0].Uid.Int64(), Equals, int64(5))
+	c.Assert(withdrawals[0].Denomination.Int64(), Equals, int64(1))
+	c.Assert(withdrawals[0].Raw.Address, Equals, rootChainAddr)
+
+	// Events that weren't emitted by the tx should decode to an empty list
+	resets, err := receipt.CoinResetEvents()
+	c.Assert(err, IsNil)
+	c.Assert(resets, HasLen, 0)
+
+	var exit RootChainStartedExit
+	c.Assert(receipt.Event("StartedExit").Decode(&exit), IsNil)
+	c.Assert(exit.Slot, Equals, uint64(3))
+}
@@ -0,0 +1,146 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/loomnetwork/go-loom/client/plasma_cash"
+)
+
+const (
+	// How long after an exit is started it can be finalized, mirrors MATURITY_PERIOD in RootChain.sol.
+	ExitMaturityPeriod = 7 * 24 * time.Hour
+	// How long after an exit is started it can be challenged with challengeBefore, mirrors
+	// CHALLENGE_WINDOW in RootChain.sol.
+	ExitChallengeWindow = 3*24*time.Hour + 12*time.Hour
+)
+
+// Exit is the latest exit of a coin, as stored in the RootChain contract.
+type Exit struct {
+	Slot  uint64
+	Owner common.Address
+	// Plasma blocks containing the parent of the exiting tx, and the exiting tx
+	PrevBlock *big.Int
+	ExitBlock *big.Int
+	// State of the coin, the exit is only in progress while the coin is EXITING
+	State     plasma_cash.PlasmaCoinState
+	CreatedAt time.Time
+}
+
+// InProgress returns true if the exit hasn't been finalized, cancelled, or reset yet.
+func (e *Exit) InProgress() bool {
+	return e.State == coinStateExiting
+}
+
+// Challengeable returns true if the exit can still be challenged with challengeBefore at the given
+// time, which should be the timestamp of the latest Ethereum block.
+func (e *Exit) Challengeable(now time.Time) bool {
+	return e.InProgress() && !now.After(e.CreatedAt.Add(ExitChallengeWindow))
+}
+
+// Finalizable returns true if the exit has matured at the given time, which should be the timestamp
+// of the latest Ethereum block. A matured exit that still has pending challenges will be reset
+// rather than finalized when finalizeExit is called.
+func (e *Exit) Finalizable(now time.Time) bool {
+	return e.InProgress() && now.After(e.CreatedAt.Add(ExitMaturityPeriod))
+}
+
+// ExitChallenge is a challengeBefore challenge of an exit, as stored in the RootChain contract.
+type ExitChallenge struct {
+	// Owner of the challenging tx, the response must be signed by this account
+	Owner      common.Address
+	Challenger common.Address
+	// Hash of the Plasma tx used to challenge the exit
+	TxHash                 [32]byte
+	ChallengingBlockNumber *big.Int
+}
+
+// Exit returns the latest exit of the given slot, the exit data isn't cleared when an exit is
+// finalized or reset, so the coin state should be checked before relying on it.
+func (d *RootChainService) Exit(ctx context.Context, slot uint64) (*Exit, error) {
+	owner, prevBlock, exitBlock, state, createdAt, err := d.plasmaContract.GetExit(d.callOpts(ctx), slot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query exit of slot %d from RootChain", slot)
+	}
+	return &Exit{
+		Slot:      slot,
+		Owner:     owner,
+		PrevBlock: prevBlock,
+		ExitBlock: exitBlock,
+		State:     plasma_cash.PlasmaCoinState(state),
+		CreatedAt: time.Unix(createdAt.Int64(), 0),
+	}, nil
+}
+
+// ExitChallenge returns the challenge of the exit of the given slot that was submitted with the
+// given tx, the call will fail if there's no such challenge (e.g. if it was responded to).
+func (d *RootChainService) ExitChallenge(ctx context.Context, slot uint64, txHash [32]byte) (*ExitChallenge, error) {
+	owner, challenger, challengeTxHash, blockNum, err := d.plasmaContract.GetChallenge(d.callOpts(ctx), slot, txHash)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query challenge %x of slot %d from RootChain", txHash, slot)
+	}
+	return &ExitChallenge{
+		Owner:                  owner,
+		Challenger:             challenger,
+		TxHash:                 challengeTxHash,
+		ChallengingBlockNumber: blockNum,
+	}, nil
+}
+
+// PendingChallenges returns the challenges of the exit of the given slot that haven't been
+// responded to. The contract doesn't expose the list of challenges, so the ChallengedExit events
+// emitted since the exit was started are checked against the contract one by one.
+func (d *RootChainService) PendingChallenges(ctx context.Context, slot uint64) ([]*ExitChallenge, error) {
+	exit, err := d.Exit(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	if !exit.InProgress() {
+		return nil, nil
+	}
+	exits, err := d.FilterStartedExitEvents(&bind.FilterOpts{Context: ctx}, []uint64{slot}, []common.Address{exit.Owner})
+	if err != nil {
+		return nil, err
+	}
+	if len(exits) == 0 {
+		return nil, nil
+	}
+	events, err := d.FilterChallengedExitEvents(
+		&bind.FilterOpts{Start: exits[len(exits)-1].Raw.BlockNumber, Context: ctx},
+		[]uint64{slot},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var challenges []*ExitChallenge
+	for _, event := range events {
+		challenge, err := d.ExitChallenge(ctx, slot, event.TxHash)
+		if err != nil {
+			// getChallenge reverts once a challenge has been responded to
+			if isContractRevert(err) {
+				continue
+			}
+			return nil, err
+		}
+		if challenge.TxHash == event.TxHash {
+			challenges = append(challenges, challenge)
+		}
+	}
+	return challenges, nil
+}
+
+// isContractRevert returns true if the given error indicates a contract call reverted. Ganache
+// reports reverts as errors, while geth returns an empty result that fails to unpack.
+func isContractRevert(err error) bool {
+	msg := errors.Cause(err).Error()
+	return strings.Contains(msg, "revert") ||
+		strings.Contains(msg, "invalid opcode") ||
+		strings.Contains(msg, "unmarshalling empty output")
+}
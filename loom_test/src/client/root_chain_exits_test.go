@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"ethcontract"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "gopkg.in/check.v1"
+)
+
+type RootChainExitsTestSuite struct {
+	backend   *testBackend
+	rootChain *RootChainService
+	owner     common.Address
+}
+
+var _ = Suite(&RootChainExitsTestSuite{})
+
+const exitingSlot = uint64(3)
+
+// SetUpTest starts an exit of the coin in exitingSlot, which is challenged twice.
+func (s *RootChainExitsTestSuite) SetUpTest(c *C) {
+	contractAddr := common.HexToAddress("0x9e51aeeeca736cd81d27e025465834b8ec08628a")
+	s.owner = common.HexToAddress("0x1aa76056924bf4768d63357eca6d6a56ec929131")
+	s.backend = newTestBackend()
+	plasmaContract, err := ethcontract.NewRootChain(contractAddr, s.backend)
+	c.Assert(err, IsNil)
+	s.rootChain = &RootChainService{
+		contractAddr:   contractAddr,
+		backend:        s.backend,
+		plasmaContract: plasmaContract,
+	}
+
+	s.backend.expectCall(c, rootChainABI, "getExit", []interface{}{exitingSlot},
+		s.owner, big.NewInt(1000), big.NewInt(2000), uint8(coinStateExiting), big.NewInt(1538000000))
+
+	challenged := rootChainABI.Events["ChallengedExit"]
+	s.backend.logs = append(s.backend.logs, types.Log{
+		Address:     contractAddr,
+		Topics:      []common.Hash{rootChainABI.Events["StartedExit"].Id(), slotTopics([]uint64{exitingSlot})[0], s.owner.Hash()},
+		BlockNumber: 10,
+	})
+	for i, txHash := range []common.Hash{common.HexToHash("0x0a"), common.HexToHash("0x0b")} {
+		data, err := challenged.Inputs.NonIndexed().Pack([32]byte(txHash), big.NewInt(3000))
+		c.Assert(err, IsNil)
+		s.backend.logs = append(s.backend.logs, types.Log{
+			Address:     contractAddr,
+			Topics:      []common.Hash{challenged.Id(), slotTopics([]uint64{exitingSlot})[0]},
+			Data:        data,
+			BlockNumber: uint64(11 + i),
+		})
+	}
+}
+
+func (s *RootChainExitsTestSuite) expectChallenge(c *C, txHash common.Hash) {
+	s.backend.expectCall(c, rootChainABI, "getChallenge", []interface{}{exitingSlot, [32]byte(txHash)},
+		s.owner, common.HexToAddress("0x02"), [32]byte(txHash), big.NewInt(3000))
+}
+
+func (s *RootChainExitsTestSuite) TestExit(c *C) {
+	exit, err := s.rootChain.Exit(context.Background(), exitingSlot)
+	c.Assert(err, IsNil)
+	c.Assert(exit.Owner, Equals, s.owner)
+	c.Assert(exit.ExitBlock.Int64(), Equals, int64(2000))
+	c.Assert(exit.InProgress(), Equals, true)
+	c.Assert(exit.CreatedAt.Unix(), Equals, int64(1538000000))
+}
+
+func (s *RootChainExitsTestSuite) TestPendingChallenges(c *C) {
+	// The second challenge has been responded to, so getChallenge reverts
+	s.expectChallenge(c, common.HexToHash("0x0a"))
+	challenges, err := s.rootChain.PendingChallenges(context.Background(), exitingSlot)
+	c.Assert(err, IsNil)
+	c.Assert(challenges, HasLen, 1)
+	c.Assert(common.Hash(challenges[0].TxHash), Equals, common.HexToHash("0x0a"))
+	c.Assert(challenges[0].Owner, Equals, s.owner)
+
+	s.expectChallenge(c, common.HexToHash("0x0b"))
+	challenges, err = s.rootChain.PendingChallenges(context.Background(), exitingSlot)
+	c.Assert(err, IsNil)
+	c.Assert(challenges, HasLen, 2)
+}
+
+func (s *RootChainExitsTestSuite) TestPendingChallengesQueryFailure(c *C) {
+	s.expectChallenge(c, common.HexToHash("0x0a"))
+	s.backend.failCall(c, rootChainABI, "getChallenge", []interface{}{exitingSlot, [32]byte(common.HexToHash("0x0b"))},
+		errors.New("connection refused"))
+
+	// Challenges that can't be checked mustn't be mistaken for challenges that were responded to
+	_, err := s.rootChain.PendingChallenges(context.Background(), exitingSlot)
+	c.Assert(err, ErrorMatches, ".*connection refused")
+}
+
+func (s *RootChainExitsTestSuite) TestExitBondMustMatchConfig(c *C) {
+	s.backend.expectCall(c, rootChainABI, "BOND_AMOUNT", nil, big.NewInt(100000000000000000))
+
+	s.rootChain.configExitBond = big.NewInt(50000000000000000)
+	_, err := s.rootChain.ExitBond(context.Background())
+	c.Assert(err, ErrorMatches, "configured exit bond .* doesn't match .*")
+
+	s.rootChain.configExitBond = big.NewInt(100000000000000000)
+	bond, err := s.rootChain.ExitBond(context.Background())
+	c.Assert(err, IsNil)
+	c.Assert(bond.String(), Equals, "100000000000000000")
+}
+
+func (s *RootChainExitsTestSuite) TestStartedAndChallengedExits(c *C) {
+	exits, err := s.rootChain.StartedExits(context.Background(), exitingSlot, 0, 20)
+	c.Assert(err, IsNil)
+	c.Assert(exits, HasLen, 1)
+	c.Assert(exits[0].Owner, Equals, s.owner)
+	c.Assert(exits[0].ExitBlock.Int64(), Equals, int64(2000))
+	c.Assert(exits[0].EthBlockNumber, Equals, uint64(10))
+
+	challenges, err := s.rootChain.ChallengedExits(context.Background(), exitingSlot, 12, 20)
+	c.Assert(err, IsNil)
+	c.Assert(challenges, HasLen, 1)
+	c.Assert(common.Hash(challenges[0].TxHash), Equals, common.HexToHash("0x0b"))
+	c.Assert(challenges[0].ChallengingBlockNumber.Int64(), Equals, int64(3000))
+	c.Assert(challenges[0].ExitOwner, Equals, s.owner)
+	c.Assert(challenges[0].EthBlockNumber, Equals, uint64(12))
+}
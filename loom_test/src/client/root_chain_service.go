@@ -4,32 +4,123 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"errors"
+	"ethcontract"
 	"fmt"
-	"log"
 	"math/big"
 	"os"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/ethclient"
 
 	"github.com/loomnetwork/go-loom/client/plasma_cash"
-	"github.com/loomnetwork/go-loom/client/plasma_cash/eth/ethcontract"
 )
 
+// RootChainClient extends plasma_cash.RootChainClient with the additional RootChain queries that
+// Client needs, which aren't exposed by go-loom.
+type RootChainClient interface {
+	plasma_cash.RootChainClient
+	RootChainEventFilterer
+
+	// Context-first variants of the plasma_cash.RootChainClient methods.
+	PlasmaCoinContext(ctx context.Context, slot uint64) (*plasma_cash.PlasmaCoin, error)
+	StartExitContext(ctx context.Context, slot uint64, prevTx plasma_cash.Tx, exitingTx plasma_cash.Tx,
+		prevTxInclusion plasma_cash.Proof, exitingTxInclusion plasma_cash.Proof, sigs []byte,
+		prevTxIncBlock *big.Int, exitingTxIncBlock *big.Int) ([]byte, error)
+	ChallengeBeforeContext(ctx context.Context, slot uint64, exitingTx plasma_cash.Tx,
+		exitingTxInclusionProof plasma_cash.Proof, sig []byte, exitingTxBlockNum *big.Int) ([]byte, error)
+	RespondChallengeBeforeContext(ctx context.Context, slot uint64, challengingTxHash [32]byte,
+		respondingBlockNumber *big.Int, respondingTx plasma_cash.Tx, proof plasma_cash.Proof, sig []byte) ([]byte, error)
+	ChallengeBetweenContext(ctx context.Context, slot uint64, challengingBlockNumber *big.Int,
+		challengingTx plasma_cash.Tx, proof plasma_cash.Proof, sig []byte) ([]byte, error)
+	ChallengeAfterContext(ctx context.Context, slot uint64, challengingBlockNumber *big.Int,
+		challengingTx plasma_cash.Tx, proof plasma_cash.Proof, sig []byte) ([]byte, error)
+	DepositEventDataContext(ctx context.Context, txHash common.Hash) (*plasma_cash.DepositEventData, error)
+	ChallengedExitEventDataContext(ctx context.Context, txHash common.Hash) (*plasma_cash.ChallengedExitEventData, error)
+
+	// Variants of the plasma_cash.RootChainClient methods that return the hash of the sent tx,
+	// which can be passed to WaitMined to find out if the tx succeeded.
+	WithdrawTx(ctx context.Context, slot uint64) (common.Hash, error)
+	CancelExitTx(ctx context.Context, slot uint64) (common.Hash, error)
+	CancelExitsTx(ctx context.Context, slots []uint64) (common.Hash, error)
+	FinalizeExitTx(ctx context.Context, slot uint64) (common.Hash, error)
+	FinalizeExitsTx(ctx context.Context, slots []uint64) (common.Hash, error)
+	WithdrawBondsTx(ctx context.Context) (common.Hash, error)
+	SubmitBlockTx(ctx context.Context, blockNum *big.Int, merkleRoot [32]byte) (common.Hash, error)
+	// Deposit ETH, ERC20, and ERC721 coins into the RootChain contract, any token approvals needed
+	// by the deposit are sent (and mined) before the deposit tx.
+	DepositETHTx(ctx context.Context, amount *big.Int) (common.Hash, error)
+	DepositERC20Tx(ctx context.Context, tokenAddr common.Address, amount *big.Int) (common.Hash, error)
+	DepositERC721Tx(ctx context.Context, tokenAddr common.Address, uid *big.Int) (common.Hash, error)
+	// WaitMined waits for the given tx to be mined and returns its receipt, if the tx was reverted
+	// the receipt is returned along with ErrTxReverted.
+	WaitMined(ctx context.Context, txHash common.Hash) (*TxReceipt, error)
+
+	// EthBlockNumber returns the number of the latest Ethereum block.
+	EthBlockNumber(ctx context.Context) (uint64, error)
+	// StartedExits returns the exits of the given slot that were started within the given range of
+	// Ethereum blocks (inclusive).
+	StartedExits(ctx context.Context, slot uint64, startBlock uint64, endBlock uint64) ([]*ExitEvent, error)
+	// ChallengedExits returns the challengeBefore challenges of the exits of the given slot that
+	// were submitted within the given range of Ethereum blocks (inclusive).
+	ChallengedExits(ctx context.Context, slot uint64, startBlock uint64, endBlock uint64) ([]*ChallengeEvent, error)
+	// BlockRoot returns the merkle root of the given Plasma block, as submitted to the RootChain.
+	BlockRoot(ctx context.Context, blockNum *big.Int) ([32]byte, error)
+	// ExitBond returns the bond (in wei) that must be sent along with exits & challengeBefore
+	// challenges.
+	ExitBond(ctx context.Context) (*big.Int, error)
+	// Bonds returns the bonds the RootChain contract holds for the given account.
+	Bonds(ctx context.Context, account common.Address) (*BondBalance, error)
+	// Exit returns the latest exit of the given slot.
+	Exit(ctx context.Context, slot uint64) (*Exit, error)
+	// ExitChallenge returns the challenge of the exit of the given slot that was submitted with
+	// the given tx.
+	ExitChallenge(ctx context.Context, slot uint64, txHash [32]byte) (*ExitChallenge, error)
+	// PendingChallenges returns the challenges of the exit of the given slot that haven't been
+	// responded to.
+	PendingChallenges(ctx context.Context, slot uint64) ([]*ExitChallenge, error)
+}
+
+// BondBalance is the amount of ETH (in wei) the RootChain contract holds in bonds for an account.
+type BondBalance struct {
+	// Bonds of exits & challenges that are still in progress
+	Bonded *big.Int
+	// Bonds that have been freed, or slashed in favour of the account, and can be withdrawn
+	Withdrawable *big.Int
+}
+
 type RootChainService struct {
 	Name           string
+	contractAddr   common.Address
+	backend        Backend
 	plasmaContract *ethcontract.RootChain
 	callerKey      *ecdsa.PrivateKey
 	callerAddr     common.Address
-	transactOpts   *bind.TransactOpts
-	callOpts       *bind.CallOpts
+	transactor     *transactor
+
+	exitBondMutex sync.Mutex
+	exitBond      *big.Int
+	// Exit bond from the config, checked against the RootChain contract
+	configExitBond *big.Int
+}
+
+// callOpts returns the options for a contract call that should be made within the given context.
+func (d *RootChainService) callOpts(ctx context.Context) *bind.CallOpts {
+	return &bind.CallOpts{
+		From:    d.callerAddr,
+		Context: ctx,
+	}
 }
 
 func (d *RootChainService) PlasmaCoin(slot uint64) (*plasma_cash.PlasmaCoin, error) {
+	return d.PlasmaCoinContext(context.Background(), slot)
+}
+
+func (d *RootChainService) PlasmaCoinContext(ctx context.Context, slot uint64) (*plasma_cash.PlasmaCoin, error) {
 	uid, depositBlockNum, denom, ownerAddr, state, mode, contractAddr, err := d.plasmaContract.GetPlasmaCoin(
-		&bind.CallOpts{From: d.callerAddr},
+		d.callOpts(ctx),
 		slot,
 	)
 	if err != nil {
@@ -46,12 +137,79 @@ func (d *RootChainService) PlasmaCoin(slot uint64) (*plasma_cash.PlasmaCoin, err
 	}, nil
 }
 
+func (d *RootChainService) BlockRoot(ctx context.Context, blockNum *big.Int) ([32]byte, error) {
+	return d.plasmaContract.GetBlockRoot(d.callOpts(ctx), blockNum)
+}
+
+// ExitBond queries the exit bond from the RootChain contract. If the config specifies the bond
+// it must match the contract, a mismatched bond would only be discovered once exits start failing.
+func (d *RootChainService) ExitBond(ctx context.Context) (*big.Int, error) {
+	d.exitBondMutex.Lock()
+	defer d.exitBondMutex.Unlock()
+
+	if d.exitBond == nil {
+		bond, err := d.plasmaContract.BONDAMOUNT(d.callOpts(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("failed to query exit bond from RootChain: %v", err)
+		}
+		if d.configExitBond != nil && d.configExitBond.Cmp(bond) != 0 {
+			return nil, fmt.Errorf("configured exit bond of %v wei doesn't match the RootChain exit bond of %v wei",
+				d.configExitBond, bond)
+		}
+		d.exitBond = bond
+	}
+	return new(big.Int).Set(d.exitBond), nil
+}
+
+func (d *RootChainService) Bonds(ctx context.Context, account common.Address) (*BondBalance, error) {
+	balance, err := d.plasmaContract.Balances(d.callOpts(ctx), account)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query bonds of %s from RootChain: %v", account.Hex(), err)
+	}
+	return &BondBalance{Bonded: balance.Bonded, Withdrawable: balance.Withdrawable}, nil
+}
+
+// bondForTx returns the exit bond, or an error if the caller's ETH balance can't cover the bond
+// (and the gas cost of the tx, if a fixed gas price & limit are used).
+func (d *RootChainService) bondForTx(ctx context.Context) (*big.Int, error) {
+	bond, err := d.ExitBond(ctx)
+	if err != nil {
+		return nil, err
+	}
+	required := new(big.Int).Set(bond)
+	if defaults := d.transactor.defaults; defaults.GasPrice != nil {
+		gasCost := new(big.Int).SetUint64(defaults.GasLimit)
+		required.Add(required, gasCost.Mul(gasCost, defaults.GasPrice))
+	}
+	balance, err := d.backend.BalanceAt(ctx, d.callerAddr, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve ETH balance of %s: %v", d.callerAddr.Hex(), err)
+	}
+	if balance.Cmp(required) < 0 {
+		return nil, fmt.Errorf("%s has insufficient ETH balance to cover exit bond of %v wei, balance: %v wei, required: %v wei",
+			d.callerAddr.Hex(), bond, balance, required)
+	}
+	return bond, nil
+}
+
 func (d *RootChainService) Withdraw(slot uint64) error {
-	_, err := d.plasmaContract.Withdraw(d.transactOpts, slot)
+	_, err := d.WithdrawTx(context.Background(), slot)
 	return err
 }
 
+// WithdrawTx sends a tx that withdraws the coin at the given slot, and returns the tx hash.
+func (d *RootChainService) WithdrawTx(ctx context.Context, slot uint64) (common.Hash, error) {
+	return d.transactor.transact(&txOptions{Context: ctx}, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return d.plasmaContract.Withdraw(opts, slot)
+	})
+}
+
 func (d *RootChainService) ChallengeBefore(slot uint64, exitingTx plasma_cash.Tx,
+	exitingTxInclusionProof plasma_cash.Proof, sig []byte, exitingTxBlockNum *big.Int) ([]byte, error) {
+	return d.ChallengeBeforeContext(context.Background(), slot, exitingTx, exitingTxInclusionProof, sig, exitingTxBlockNum)
+}
+
+func (d *RootChainService) ChallengeBeforeContext(ctx context.Context, slot uint64, exitingTx plasma_cash.Tx,
 	exitingTxInclusionProof plasma_cash.Proof, sig []byte, exitingTxBlockNum *big.Int) ([]byte, error) {
 	var err error
 	exitingTxBytes, err := exitingTx.RlpEncode()
@@ -59,65 +217,99 @@ func (d *RootChainService) ChallengeBefore(slot uint64, exitingTx plasma_cash.Tx
 		return nil, err
 	}
 
-	d.transactOpts.Value = big.NewInt(100000000000000000) //0.1 eth, TODO make the bond configurable
-	tx, err := d.plasmaContract.ChallengeBefore(
-		d.transactOpts, slot, exitingTxBytes,
-		exitingTxInclusionProof, sig,
-		exitingTxBlockNum)
-	d.transactOpts.Value = big.NewInt(0)
+	bond, err := d.bondForTx(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return tx.Hash().Bytes(), nil
+	txHash, err := d.transactor.transact(&txOptions{Context: ctx, Value: bond}, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return d.plasmaContract.ChallengeBefore(
+			opts, slot, exitingTxBytes,
+			exitingTxInclusionProof, sig,
+			exitingTxBlockNum)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return txHash.Bytes(), nil
 }
 
 func (d *RootChainService) RespondChallengeBefore(slot uint64, challengingTxHash [32]byte, respondingBlockNumber *big.Int,
 	respondingTx plasma_cash.Tx, proof plasma_cash.Proof, sig []byte) ([]byte, error) {
+	return d.RespondChallengeBeforeContext(
+		context.Background(), slot, challengingTxHash, respondingBlockNumber, respondingTx, proof, sig)
+}
+
+func (d *RootChainService) RespondChallengeBeforeContext(ctx context.Context, slot uint64, challengingTxHash [32]byte,
+	respondingBlockNumber *big.Int, respondingTx plasma_cash.Tx, proof plasma_cash.Proof, sig []byte) ([]byte, error) {
 	respondingTxBytes, err := respondingTx.RlpEncode()
 	if err != nil {
 		return nil, err
 	}
-	tx, err := d.plasmaContract.RespondChallengeBefore(
-		d.transactOpts, slot, challengingTxHash, respondingBlockNumber, respondingTxBytes, proof, sig)
+	txHash, err := d.transactor.transact(&txOptions{Context: ctx}, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return d.plasmaContract.RespondChallengeBefore(
+			opts, slot, challengingTxHash, respondingBlockNumber, respondingTxBytes, proof, sig)
+	})
 	if err != nil {
 		return nil, err
 	}
-	return tx.Hash().Bytes(), nil
+	return txHash.Bytes(), nil
 }
 
 func (d *RootChainService) ChallengeBetween(slot uint64, challengingBlockNumber *big.Int,
 	challengingTx plasma_cash.Tx, proof plasma_cash.Proof, sig []byte) ([]byte, error) {
+	return d.ChallengeBetweenContext(context.Background(), slot, challengingBlockNumber, challengingTx, proof, sig)
+}
+
+func (d *RootChainService) ChallengeBetweenContext(ctx context.Context, slot uint64, challengingBlockNumber *big.Int,
+	challengingTx plasma_cash.Tx, proof plasma_cash.Proof, sig []byte) ([]byte, error) {
 
 	challengingTxBytes, err := challengingTx.RlpEncode()
 	if err != nil {
 		return nil, err
 	}
-	tx, err := d.plasmaContract.ChallengeBetween(
-		d.transactOpts, slot, challengingBlockNumber, challengingTxBytes, proof, sig)
+	txHash, err := d.transactor.transact(&txOptions{Context: ctx}, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return d.plasmaContract.ChallengeBetween(
+			opts, slot, challengingBlockNumber, challengingTxBytes, proof, sig)
+	})
 	if err != nil {
 		return nil, err
 	}
-	return tx.Hash().Bytes(), nil
+	return txHash.Bytes(), nil
 }
 
 func (d *RootChainService) ChallengeAfter(slot uint64, challengingBlockNumber *big.Int,
 	challengingTx plasma_cash.Tx, proof plasma_cash.Proof, sig []byte) ([]byte, error) {
+	return d.ChallengeAfterContext(context.Background(), slot, challengingBlockNumber, challengingTx, proof, sig)
+}
+
+func (d *RootChainService) ChallengeAfterContext(ctx context.Context, slot uint64, challengingBlockNumber *big.Int,
+	challengingTx plasma_cash.Tx, proof plasma_cash.Proof, sig []byte) ([]byte, error) {
 
 	challengingTxBytes, err := challengingTx.RlpEncode()
 	if err != nil {
 		return nil, err
 	}
-	tx, err := d.plasmaContract.ChallengeAfter(
-		d.transactOpts, slot, challengingBlockNumber, challengingTxBytes, proof, sig)
+	txHash, err := d.transactor.transact(&txOptions{Context: ctx}, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return d.plasmaContract.ChallengeAfter(
+			opts, slot, challengingBlockNumber, challengingTxBytes, proof, sig)
+	})
 	if err != nil {
 		return nil, err
 	}
-	return tx.Hash().Bytes(), nil
+	return txHash.Bytes(), nil
 }
 
 func (d *RootChainService) StartExit(
 	slot uint64, prevTx plasma_cash.Tx, exitingTx plasma_cash.Tx, prevTxInclusion plasma_cash.Proof, exitingTxInclusion plasma_cash.Proof,
 	sigs []byte, prevTxIncBlock *big.Int, exitingTxIncBlock *big.Int) ([]byte, error) {
+	return d.StartExitContext(
+		context.Background(), slot, prevTx, exitingTx, prevTxInclusion, exitingTxInclusion,
+		sigs, prevTxIncBlock, exitingTxIncBlock)
+}
+
+func (d *RootChainService) StartExitContext(ctx context.Context,
+	slot uint64, prevTx plasma_cash.Tx, exitingTx plasma_cash.Tx, prevTxInclusion plasma_cash.Proof, exitingTxInclusion plasma_cash.Proof,
+	sigs []byte, prevTxIncBlock *big.Int, exitingTxIncBlock *big.Int) ([]byte, error) {
 
 	var prevTxBytes []byte
 	var err error
@@ -132,65 +324,119 @@ func (d *RootChainService) StartExit(
 	if err != nil {
 		return nil, err
 	}
-	d.transactOpts.Value = big.NewInt(100000000000000000) //0.1 eth, TODO make the bond configurable
+	bond, err := d.bondForTx(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	exitblocks := [2]*big.Int{prevTxIncBlock, exitingTxIncBlock}
-	tx, err := d.plasmaContract.StartExit(
-		d.transactOpts, slot,
-		prevTxBytes, exitingTxBytes, prevTxInclusion, exitingTxInclusion,
-		sigs, exitblocks)
-
-	d.transactOpts.Value = big.NewInt(0)
+	txHash, err := d.transactor.transact(&txOptions{Context: ctx, Value: bond}, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return d.plasmaContract.StartExit(
+			opts, slot,
+			prevTxBytes, exitingTxBytes, prevTxInclusion, exitingTxInclusion,
+			sigs, exitblocks)
+	})
 	if err != nil {
 		return nil, err
 	}
-	return tx.Hash().Bytes(), nil
+	return txHash.Bytes(), nil
 }
 
 func (d *RootChainService) CancelExit(slot uint64) error {
-	_, err := d.plasmaContract.CancelExit(d.transactOpts, slot)
+	_, err := d.CancelExitTx(context.Background(), slot)
 	return err
 }
 
+// CancelExitTx sends a tx that cancels the exit of the coin at the given slot, and returns the
+// tx hash.
+func (d *RootChainService) CancelExitTx(ctx context.Context, slot uint64) (common.Hash, error) {
+	return d.transactor.transact(&txOptions{Context: ctx}, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return d.plasmaContract.CancelExit(opts, slot)
+	})
+}
+
 func (d *RootChainService) CancelExits(slots []uint64) error {
-	_, err := d.plasmaContract.CancelExits(d.transactOpts, slots)
+	_, err := d.CancelExitsTx(context.Background(), slots)
 	return err
 }
 
+// CancelExitsTx sends a tx that cancels the exits of the coins at the given slots, and returns the
+// tx hash.
+func (d *RootChainService) CancelExitsTx(ctx context.Context, slots []uint64) (common.Hash, error) {
+	return d.transactor.transact(&txOptions{Context: ctx}, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return d.plasmaContract.CancelExits(opts, slots)
+	})
+}
 
 func (d *RootChainService) FinalizeExit(slot uint64) error {
-	_, err := d.plasmaContract.FinalizeExit(d.transactOpts, slot)
+	_, err := d.FinalizeExitTx(context.Background(), slot)
 	return err
 }
 
+// FinalizeExitTx sends a tx that finalizes the exit of the coin at the given slot, and returns the
+// tx hash.
+func (d *RootChainService) FinalizeExitTx(ctx context.Context, slot uint64) (common.Hash, error) {
+	return d.transactor.transact(&txOptions{Context: ctx}, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return d.plasmaContract.FinalizeExit(opts, slot)
+	})
+}
+
 func (d *RootChainService) FinalizeExits(slots []uint64) error {
-	_, err := d.plasmaContract.FinalizeExits(d.transactOpts, slots)
+	_, err := d.FinalizeExitsTx(context.Background(), slots)
 	return err
 }
 
+// FinalizeExitsTx sends a tx that finalizes the exits of the coins at the given slots, and returns
+// the tx hash.
+func (d *RootChainService) FinalizeExitsTx(ctx context.Context, slots []uint64) (common.Hash, error) {
+	return d.transactor.transact(&txOptions{Context: ctx}, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return d.plasmaContract.FinalizeExits(opts, slots)
+	})
+}
+
 func (d *RootChainService) WithdrawBonds() error {
-	_, err := d.plasmaContract.WithdrawBonds(d.transactOpts)
+	_, err := d.WithdrawBondsTx(context.Background())
 	return err
 }
 
+// WithdrawBondsTx sends a tx that withdraws the caller's freed bonds, and returns the tx hash.
+func (d *RootChainService) WithdrawBondsTx(ctx context.Context) (common.Hash, error) {
+	return d.transactor.transact(&txOptions{Context: ctx}, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return d.plasmaContract.WithdrawBonds(opts)
+	})
+}
+
 func (d *RootChainService) SubmitBlock(blockNum *big.Int, merkleRoot [32]byte) error {
-	_, err := d.plasmaContract.SubmitBlock(d.transactOpts, blockNum, merkleRoot)
+	_, err := d.SubmitBlockTx(context.Background(), blockNum, merkleRoot)
 	return err
 }
 
+// SubmitBlockTx sends a tx that submits the root of a Plasma block, and returns the tx hash.
+func (d *RootChainService) SubmitBlockTx(ctx context.Context, blockNum *big.Int, merkleRoot [32]byte) (common.Hash, error) {
+	return d.transactor.transact(&txOptions{Context: ctx}, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return d.plasmaContract.SubmitBlock(opts, blockNum, merkleRoot)
+	})
+}
+
+// WaitMined waits for the given tx to be mined and returns its receipt, along with the RootChain
+// events emitted by the tx. If the tx was reverted the receipt is returned along with ErrTxReverted.
+func (d *RootChainService) WaitMined(ctx context.Context, txHash common.Hash) (*TxReceipt, error) {
+	return waitMined(ctx, d.backend, d.contractAddr, txHash)
+}
+
 func (d *RootChainService) DebugCoinMetaData(slots []uint64) {
 	if os.Getenv("DEBUG") != "true" {
 		return
 	}
 
-	coins, err := d.plasmaContract.NumCoins(d.callOpts) //todo make this readonly
+	coins, err := d.plasmaContract.NumCoins(d.callOpts(context.Background())) //todo make this readonly
 	fmt.Printf("Num coins -%v\n", coins)
 	if err != nil {
 		panic(err)
 	}
 	for _, y := range slots {
 		//slot, c.depositBlock, c.denomination, c.owner, c.state
-		returnSlot, _, _, owner, state, _, _, err := d.plasmaContract.GetPlasmaCoin(d.callOpts, y)
+		returnSlot, _, _, owner, state, _, _, err := d.plasmaContract.GetPlasmaCoin(d.callOpts(context.Background()), y)
 		fmt.Printf("Num coins -(slot)-%v -(returnSlot)-%v -(state)-%v -(owner)-%x\n", y, returnSlot, state, owner)
 
 		if err != nil {
@@ -200,59 +446,150 @@ func (d *RootChainService) DebugCoinMetaData(slots []uint64) {
 }
 
 func (d *RootChainService) ChallengedExitEventData(txHash common.Hash) (*plasma_cash.ChallengedExitEventData, error) {
-	receipt, err := conn.TransactionReceipt(context.TODO(), txHash)
+	return d.ChallengedExitEventDataContext(context.Background(), txHash)
+}
+
+func (d *RootChainService) ChallengedExitEventDataContext(ctx context.Context, txHash common.Hash) (*plasma_cash.ChallengedExitEventData, error) {
+	receipt, err := d.txReceipt(ctx, txHash)
 	if err != nil {
 		return &plasma_cash.ChallengedExitEventData{}, err
 	}
-	if receipt == nil {
-		return &plasma_cash.ChallengedExitEventData{}, errors.New("failed to retrieve tx receipt")
+	events, err := receipt.ChallengedExitEvents()
+	if err != nil {
+		return &plasma_cash.ChallengedExitEventData{}, err
+	}
+	if len(events) == 0 {
+		return &plasma_cash.ChallengedExitEventData{}, fmt.Errorf("no ChallengedExit event in tx %s", txHash.Hex())
 	}
-	de, err := d.plasmaContract.ChallengedExitEventData(receipt)
-	return &plasma_cash.ChallengedExitEventData{Slot: de.Slot, TxHash: de.TxHash, ChallengingBlockNumber: de.ChallengingBlockNumber}, err
+	return &plasma_cash.ChallengedExitEventData{
+		Slot:                   events[0].Slot,
+		TxHash:                 events[0].TxHash,
+		ChallengingBlockNumber: events[0].ChallengingBlockNumber,
+	}, nil
 }
 
 func (d *RootChainService) DepositEventData(txHash common.Hash) (*plasma_cash.DepositEventData, error) {
-	receipt, err := conn.TransactionReceipt(context.TODO(), txHash)
+	return d.DepositEventDataContext(context.Background(), txHash)
+}
+
+func (d *RootChainService) DepositEventDataContext(ctx context.Context, txHash common.Hash) (*plasma_cash.DepositEventData, error) {
+	receipt, err := d.txReceipt(ctx, txHash)
+	if err != nil {
+		return &plasma_cash.DepositEventData{}, err
+	}
+	events, err := receipt.DepositEvents()
 	if err != nil {
 		return &plasma_cash.DepositEventData{}, err
 	}
+	if len(events) == 0 {
+		return &plasma_cash.DepositEventData{}, fmt.Errorf("no Deposit event in tx %s", txHash.Hex())
+	}
+	return &plasma_cash.DepositEventData{Slot: events[0].Slot, BlockNum: events[0].BlockNumber}, nil
+}
+
+// txReceipt retrieves the receipt of a tx that has already been mined.
+func (d *RootChainService) txReceipt(ctx context.Context, txHash common.Hash) (*TxReceipt, error) {
+	receipt, err := d.backend.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
 	if receipt == nil {
-		return &plasma_cash.DepositEventData{}, errors.New("failed to retrieve tx receipt")
+		return nil, errors.New("failed to retrieve tx receipt")
 	}
-	de, err := d.plasmaContract.DepositEventData(receipt)
-	return &plasma_cash.DepositEventData{Slot: de.Slot, BlockNum: de.BlockNumber}, err
+	return newTxReceipt(d.contractAddr, txHash, receipt)
 }
 
-var conn *ethclient.Client
+func (d *RootChainService) EthBlockNumber(ctx context.Context) (uint64, error) {
+	header, err := d.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	return header.Number.Uint64(), nil
+}
 
-func InitClients(connStr string) {
-	var err error
-	conn, err = ethclient.Dial(connStr)
+func (d *RootChainService) StartedExits(ctx context.Context, slot uint64, startBlock uint64, endBlock uint64) ([]*ExitEvent, error) {
+	events, err := d.FilterStartedExitEvents(
+		&bind.FilterOpts{Start: startBlock, End: &endBlock, Context: ctx},
+		[]uint64{slot}, nil,
+	)
 	if err != nil {
-		log.Fatalf("Failed to connect to the Ethereum client: %v", err)
+		return nil, err
 	}
+
+	exits := make([]*ExitEvent, 0, len(events))
+	for _, event := range events {
+		// The StartedExit event doesn't include the exit blocks so they have to be looked up
+		exit, err := d.Exit(ctx, event.Slot)
+		if err != nil {
+			return nil, err
+		}
+		// If the exit was already finalized, cancelled, or challenged the exit data will have
+		// been cleared, or may even belong to a newer exit.
+		if exit.Owner != event.Owner {
+			exit.PrevBlock, exit.ExitBlock = nil, nil
+		}
+		exits = append(exits, &ExitEvent{
+			Slot:           event.Slot,
+			Owner:          event.Owner,
+			PrevBlock:      exit.PrevBlock,
+			ExitBlock:      exit.ExitBlock,
+			EthBlockNumber: event.Raw.BlockNumber,
+			EthTxHash:      event.Raw.TxHash,
+		})
+	}
+	return exits, nil
 }
 
-func NewRootChainService(callerName string, callerKey *ecdsa.PrivateKey, boundContract *ethcontract.RootChain) *RootChainService {
-	auth := bind.NewKeyedTransactor(callerKey)
-	// If gas price isn't set explicitely then go-ethereum will attempt to query the suggested gas
-	// price, unfortunatley ganache-cli v6.1.2 seems to encode the gas price in a format go-ethereum
-	// can't decode correctly, so this error is returned whenver you attempt to call a contract:
-	// failed to suggest gas price: json: cannot unmarshal hex number with leading zero digits into Go value of type *hexutil.Big
-	//
-	// Earlier versions of ganache-cli don't seem to exhibit this issue, but they're broken in other
-	// ways (logs aren't hex-encoded correctly).
-	auth.GasPrice = big.NewInt(20000)
-	auth.GasLimit = uint64(3141592)
+func (d *RootChainService) ChallengedExits(ctx context.Context, slot uint64, startBlock uint64, endBlock uint64) ([]*ChallengeEvent, error) {
+	events, err := d.FilterChallengedExitEvents(
+		&bind.FilterOpts{Start: startBlock, End: &endBlock, Context: ctx},
+		[]uint64{slot},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	challenges := make([]*ChallengeEvent, 0, len(events))
+	for _, event := range events {
+		exit, err := d.Exit(ctx, event.Slot)
+		if err != nil {
+			return nil, err
+		}
+		challenges = append(challenges, &ChallengeEvent{
+			Slot:                   event.Slot,
+			TxHash:                 event.TxHash,
+			ChallengingBlockNumber: event.ChallengingBlockNumber,
+			ExitOwner:              exit.Owner,
+			ExitBlock:              exit.ExitBlock,
+			EthBlockNumber:         event.Raw.BlockNumber,
+			EthTxHash:              event.Raw.TxHash,
+		})
+	}
+	return challenges, nil
+}
+
+// NewRootChainService creates a wrapper for the RootChain contract at the given address, the
+// contract will be accessed via the given backend. Txs are sent with the given nonce manager, which
+// may be nil if no other wrapper sends txs from the caller's account.
+func NewRootChainService(backend Backend, callerName string, callerKey *ecdsa.PrivateKey,
+	contractAddr common.Address, ethCfg *EthConfig, nonces *NonceManager) (*RootChainService, error) {
+	plasmaContract, err := ethcontract.NewRootChain(contractAddr, backend)
+	if err != nil {
+		return nil, err
+	}
+	var configExitBond *big.Int
+	if ethCfg.ExitBond != nil {
+		configExitBond = new(big.Int).Set(ethCfg.ExitBond)
+	}
 	callerAddr := crypto.PubkeyToAddress(callerKey.PublicKey)
 	return &RootChainService{
 		Name:           callerName,
 		callerKey:      callerKey,
 		callerAddr:     callerAddr,
-		plasmaContract: boundContract,
-		transactOpts:   auth,
-		callOpts: &bind.CallOpts{
-			From: callerAddr,
-		},
-	}
+		contractAddr:   contractAddr,
+		backend:        backend,
+		plasmaContract: plasmaContract,
+		transactor:     newTransactor(backend, callerKey, ethCfg, nonces),
+		configExitBond: configExitBond,
+	}, nil
 }
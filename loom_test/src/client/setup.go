@@ -7,18 +7,14 @@ import (
 	"runtime"
 	"strings"
 
-	"ethcontract"
-
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/loomnetwork/go-loom/auth"
 	"github.com/loomnetwork/go-loom/client"
-	"github.com/loomnetwork/go-loom/client/plasma_cash"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 
 	loom "github.com/loomnetwork/go-loom"
-	loom_ethcontract "github.com/loomnetwork/go-loom/client/plasma_cash/eth/ethcontract"
 
 	"crypto/ecdsa"
 	"crypto/elliptic"
@@ -34,6 +30,9 @@ type TestContext struct {
 	Trudy   *Client
 
 	Authority *Client
+	// Configures the attacks carried out by the operator, only set when testing against the
+	// HostileOperator contract.
+	HostileOperator *HostileOperatorClient
 }
 
 func getDAppchainTxSigner(name string) (auth.Signer, error) {
@@ -53,27 +52,39 @@ func getDAppchainTxSigner(name string) (auth.Signer, error) {
 	return signer, nil
 }
 
-func getTokenContract(cfg *viper.Viper, name string, privKey *ecdsa.PrivateKey) (plasma_cash.TokenContract, error) {
+// getTokenContract creates a wrapper for the token contract specified in the config, the
+// token_contract_type setting determines whether the contract is the Cards demo contract (the
+// default), or any other ERC721 contract.
+func getTokenContract(backend Backend, cfg *viper.Viper, ethCfg *EthConfig, name string, privKey *ecdsa.PrivateKey,
+	nonces *NonceManager) (TokenContract, error) {
 	tokenAddr := common.HexToAddress(cfg.GetString("token_contract"))
-	tokenContract, err := ethcontract.NewCards(tokenAddr, conn)
-	if err != nil {
-		return nil, errors.Wrapf(err, "Failed to instantiate a Token contract")
+	switch contractType := cfg.GetString("token_contract_type"); contractType {
+	case "", "cards":
+		tokenContract, err := NewTokenContract(backend, name, privKey, tokenAddr, ethCfg, nonces)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to instantiate a Token contract")
+		}
+		return tokenContract, nil
+	case "erc721":
+		rootChainAddr := common.HexToAddress(cfg.GetString("root_chain"))
+		tokenContract, err := NewERC721Contract(backend, name, privKey, tokenAddr, rootChainAddr, ethCfg, nonces)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to instantiate an ERC721 contract")
+		}
+		return tokenContract, nil
+	default:
+		return nil, errors.Errorf("unsupported token contract type %q", contractType)
 	}
-	return NewTokenContract(name, privKey, tokenContract), nil
 }
 
-func getRootChain(cfg *viper.Viper, name string) (plasma_cash.RootChainClient, error) {
+func getRootChain(backend Backend, cfg *viper.Viper, ethCfg *EthConfig, name string, privKey *ecdsa.PrivateKey,
+	nonces *NonceManager) (RootChainClient, error) {
 	contractAddr := common.HexToAddress(cfg.GetString("root_chain"))
-	privKeyHexStr := cfg.GetString(name)
-	privKey, err := crypto.HexToECDSA(strings.TrimPrefix(privKeyHexStr, "0x"))
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to load private key for %s", name)
-	}
-	plasmaContract, err := loom_ethcontract.NewRootChain(contractAddr, conn)
+	rootChain, err := NewRootChainService(backend, name, privKey, contractAddr, ethCfg, nonces)
 	if err != nil {
-		return nil, errors.Wrapf(err, "Failed to instantiate a Token contract")
+		return nil, errors.Wrapf(err, "Failed to instantiate a RootChain contract")
 	}
-	return NewRootChainService(name, privKey, plasmaContract), nil
+	return rootChain, nil
 }
 
 // Loads plasma-config.yml or equivalent from the cwd
@@ -101,7 +112,7 @@ func deriveAddressFromECPubKey(pubKey *ecdsa.PublicKey) loom.LocalAddress {
 	return crypto.Keccak256(pubKeyInBinary[1:])[12:]
 }
 
-func setupClient(cfg *viper.Viper, addressMapper *AddressMapperClient, hostile bool, entityName, readUri, writeUri string) (*Client, error) {
+func setupClient(backend Backend, cfg *viper.Viper, ethCfg *EthConfig, addressMapper *AddressMapperClient, hostile bool, entityName, readUri, writeUri string) (*Client, error) {
 	signer, err := getDAppchainTxSigner(entityName)
 	if err != nil {
 		return nil, err
@@ -145,21 +156,41 @@ func setupClient(cfg *viper.Viper, addressMapper *AddressMapperClient, hostile b
 		}
 	}
 
-	rootChainClient, err := getRootChain(cfg, entityName)
+	// The RootChain & token contract wrappers send txs from the same account
+	nonces := NewNonceManager(backend, crypto.PubkeyToAddress(privKey.PublicKey))
+	rootChainClient, err := getRootChain(backend, cfg, ethCfg, entityName, privKey, nonces)
 	if err != nil {
 		return nil, err
 	}
 
-	tokenContract, err := getTokenContract(cfg, entityName, privKey)
+	tokenContract, err := getTokenContract(backend, cfg, ethCfg, entityName, privKey, nonces)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewClient(cfg, chainServiceClient, rootChainClient, tokenContract), nil
+	c := NewClient(cfg, chainServiceClient, rootChainClient, tokenContract)
+
+	queryClient, err := NewPlasmaCashQueryClient(contractName, "default", writeUri, readUri)
+	if err != nil {
+		return nil, err
+	}
+	c.SetUserSlotsReader(queryClient)
+
+	// Persist the coin history of each entity in a separate LevelDB database if a dir is configured
+	if storeDir := cfg.GetString("coin_store_dir"); storeDir != "" {
+		store, err := NewLevelDBCoinStore(filepath.Join(storeDir, entityName))
+		if err != nil {
+			return nil, err
+		}
+		c.SetCoinStore(store)
+	}
+	return c, nil
 
 }
 
-func SetupTest(hostile bool, readUri, writeUri string) (*TestContext, error) {
+// SetupTest creates the clients of all the test entities, the clients will interact with the
+// Plasma Cash contracts via the given Ethereum backend.
+func SetupTest(backend Backend, hostile bool, readUri, writeUri string) (*TestContext, error) {
 	var err error
 	testCtx := TestContext{}
 
@@ -173,45 +204,61 @@ func SetupTest(hostile bool, readUri, writeUri string) (*TestContext, error) {
 		return nil, err
 	}
 
-	testCtx.Alice, err = setupClient(cfg, addressMapper, hostile, "alice", readUri, writeUri)
+	ethCfg, err := LoadEthConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	testCtx.Alice, err = setupClient(backend, cfg, ethCfg, addressMapper, hostile, "alice", readUri, writeUri)
 	if err != nil {
 		return nil, err
 	}
 
-	testCtx.Bob, err = setupClient(cfg, addressMapper, hostile, "bob", readUri, writeUri)
+	testCtx.Bob, err = setupClient(backend, cfg, ethCfg, addressMapper, hostile, "bob", readUri, writeUri)
 	if err != nil {
 		return nil, err
 	}
 
-	testCtx.Charlie, err = setupClient(cfg, addressMapper, hostile, "charlie", readUri, writeUri)
+	testCtx.Charlie, err = setupClient(backend, cfg, ethCfg, addressMapper, hostile, "charlie", readUri, writeUri)
 	if err != nil {
 		return nil, err
 	}
 
-	testCtx.Dan, err = setupClient(cfg, addressMapper, hostile, "dan", readUri, writeUri)
+	testCtx.Dan, err = setupClient(backend, cfg, ethCfg, addressMapper, hostile, "dan", readUri, writeUri)
 	if err != nil {
 		return nil, err
 	}
 
-	testCtx.Eve, err = setupClient(cfg, addressMapper, hostile, "eve", readUri, writeUri)
+	testCtx.Eve, err = setupClient(backend, cfg, ethCfg, addressMapper, hostile, "eve", readUri, writeUri)
 	if err != nil {
 		return nil, err
 	}
 
-	testCtx.Mallory, err = setupClient(cfg, addressMapper, hostile, "mallory", readUri, writeUri)
+	testCtx.Mallory, err = setupClient(backend, cfg, ethCfg, addressMapper, hostile, "mallory", readUri, writeUri)
 	if err != nil {
 		return nil, err
 	}
 
-	testCtx.Trudy, err = setupClient(cfg, addressMapper, hostile, "trudy", readUri, writeUri)
+	testCtx.Trudy, err = setupClient(backend, cfg, ethCfg, addressMapper, hostile, "trudy", readUri, writeUri)
 	if err != nil {
 		return nil, err
 	}
 
-	testCtx.Authority, err = setupClient(cfg, addressMapper, hostile, "authority", readUri, writeUri)
+	testCtx.Authority, err = setupClient(backend, cfg, ethCfg, addressMapper, hostile, "authority", readUri, writeUri)
 	if err != nil {
 		return nil, err
 	}
 
+	if hostile {
+		signer, err := getDAppchainTxSigner("authority")
+		if err != nil {
+			return nil, err
+		}
+		testCtx.HostileOperator, err = NewHostileOperatorClient(signer, "default", writeUri, readUri)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &testCtx, nil
 }
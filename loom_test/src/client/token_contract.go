@@ -1,49 +1,100 @@
 package client
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"ethcontract"
-	"log"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/loomnetwork/go-loom/client/plasma_cash"
+	"github.com/pkg/errors"
 
 	"github.com/ethereum/go-ethereum/crypto"
-
-	"github.com/ethereum/go-ethereum/ethclient"
 )
 
+// TokenContract extends plasma_cash.TokenContract with context-first variants of its methods.
+type TokenContract interface {
+	plasma_cash.TokenContract
+
+	RegisterContext(ctx context.Context) error
+	DepositContext(ctx context.Context, tokenID *big.Int) (common.Hash, error)
+	BalanceOfContext(ctx context.Context) (*big.Int, error)
+	// OwnerOf returns the current owner of the given token.
+	OwnerOf(ctx context.Context, tokenID *big.Int) (common.Address, error)
+	// TokensOf returns the IDs of all the tokens owned by the given account.
+	TokensOf(ctx context.Context, owner common.Address) ([]*big.Int, error)
+}
+
 type TContract struct {
 	Name          string
 	tokenContract *ethcontract.Cards
 	callerKey     *ecdsa.PrivateKey
 	callerAddr    common.Address
-	transactOpts  *bind.TransactOpts
+	transactor    *transactor
 }
 
 func (d *TContract) Deposit(tokenID *big.Int) (common.Hash, error) {
-	tx, err := d.tokenContract.DepositToPlasma(d.transactOpts, tokenID)
-	if err != nil {
-		return common.Hash{}, err
-	}
-	return tx.Hash(), err
+	return d.DepositContext(context.Background(), tokenID)
+}
+
+func (d *TContract) DepositContext(ctx context.Context, tokenID *big.Int) (common.Hash, error) {
+	return d.transactor.transact(&txOptions{Context: ctx}, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return d.tokenContract.DepositToPlasma(opts, tokenID)
+	})
 }
 
 func (d *TContract) Register() error {
-	_, err := d.tokenContract.Register(d.transactOpts)
+	return d.RegisterContext(context.Background())
+}
+
+func (d *TContract) RegisterContext(ctx context.Context) error {
+	_, err := d.transactor.transact(&txOptions{Context: ctx}, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return d.tokenContract.Register(opts)
+	})
 	return err
 }
 
 func (d *TContract) BalanceOf() (*big.Int, error) {
-	bal, err := d.tokenContract.BalanceOf(nil, d.callerAddr)
+	return d.BalanceOfContext(context.Background())
+}
+
+func (d *TContract) BalanceOfContext(ctx context.Context) (*big.Int, error) {
+	bal, err := d.tokenContract.BalanceOf(&bind.CallOpts{From: d.callerAddr, Context: ctx}, d.callerAddr)
 	if err != nil {
 		return big.NewInt(0), err
 	}
 	return bal, nil
 }
 
+func (d *TContract) OwnerOf(ctx context.Context, tokenID *big.Int) (common.Address, error) {
+	owner, err := d.tokenContract.OwnerOf(&bind.CallOpts{From: d.callerAddr, Context: ctx}, tokenID)
+	if err != nil {
+		return common.Address{}, errors.Wrapf(err, "failed to query owner of token %v", tokenID)
+	}
+	return owner, nil
+}
+
+func (d *TContract) TokensOf(ctx context.Context, owner common.Address) ([]*big.Int, error) {
+	opts := &bind.CallOpts{From: d.callerAddr, Context: ctx}
+	bal, err := d.tokenContract.BalanceOf(opts, owner)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query token balance of %s", owner.Hex())
+	}
+	count := bal.Int64()
+	tokens := make([]*big.Int, 0, count)
+	for i := int64(0); i < count; i++ {
+		tokenID, err := d.tokenContract.TokenOfOwnerByIndex(opts, owner, big.NewInt(i))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to query token %d of %s", i, owner.Hex())
+		}
+		tokens = append(tokens, tokenID)
+	}
+	return tokens, nil
+}
+
 func (d *TContract) Account() (*plasma_cash.Account, error) {
 	return &plasma_cash.Account{
 		Address:    d.callerAddr.String(),
@@ -51,32 +102,20 @@ func (d *TContract) Account() (*plasma_cash.Account, error) {
 	}, nil
 }
 
-var connToken *ethclient.Client
-
-func InitTokenClient(connStr string) {
-	var err error
-	connToken, err = ethclient.Dial(connStr)
+// NewTokenContract creates a wrapper for the token contract at the given address, the contract
+// will be accessed via the given backend. Txs are sent with the given nonce manager, which may be
+// nil if no other wrapper sends txs from the caller's account.
+func NewTokenContract(backend Backend, callerName string, callerKey *ecdsa.PrivateKey,
+	contractAddr common.Address, ethCfg *EthConfig, nonces *NonceManager) (TokenContract, error) {
+	tokenContract, err := ethcontract.NewCards(contractAddr, backend)
 	if err != nil {
-		log.Fatalf("Failed to connect to the Ethereum client: %v", err)
+		return nil, err
 	}
-}
-
-func NewTokenContract(callerName string, callerKey *ecdsa.PrivateKey, boundContract *ethcontract.Cards) plasma_cash.TokenContract {
-	auth := bind.NewKeyedTransactor(callerKey)
-	// If gas price isn't set explicitely then go-ethereum will attempt to query the suggested gas
-	// price, unfortunatley ganache-cli v6.1.2 seems to encode the gas price in a format go-ethereum
-	// can't decode correctly, so this error is returned whenver you attempt to call a contract:
-	// failed to suggest gas price: json: cannot unmarshal hex number with leading zero digits into Go value of type *hexutil.Big
-	//
-	// Earlier versions of ganache-cli don't seem to exhibit this issue, but they're broken in other
-	// ways (logs aren't hex-encoded correctly).
-	auth.GasPrice = big.NewInt(20000)
-	auth.GasLimit = uint64(3141592)
 	return &TContract{
 		Name:          callerName,
-		tokenContract: boundContract,
+		tokenContract: tokenContract,
 		callerKey:     callerKey,
 		callerAddr:    crypto.PubkeyToAddress(callerKey.PublicKey),
-		transactOpts:  auth,
-	}
+		transactor:    newTransactor(backend, callerKey, ethCfg, nonces),
+	}, nil
 }
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"ethcontract"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "gopkg.in/check.v1"
+)
+
+type TokenContractTestSuite struct{}
+
+var _ = Suite(&TokenContractTestSuite{})
+
+// testBackend answers contract calls with canned results, and log queries with canned logs, any
+// other use of the backend panics.
+type testBackend struct {
+	Backend
+	results map[string][]byte
+	errs    map[string]error
+	logs    []types.Log
+}
+
+func newTestBackend() *testBackend {
+	return &testBackend{results: make(map[string][]byte), errs: make(map[string]error)}
+}
+
+// expectCall makes the backend return the given results when the given method is called with args.
+func (b *testBackend) expectCall(c *C, contractABI abi.ABI, method string, args []interface{}, results ...interface{}) {
+	input, err := contractABI.Pack(method, args...)
+	c.Assert(err, IsNil)
+	output, err := contractABI.Methods[method].Outputs.Pack(results...)
+	c.Assert(err, IsNil)
+	b.results[string(input)] = output
+	delete(b.errs, string(input))
+}
+
+// failCall makes the backend return the given error when the given method is called with args.
+func (b *testBackend) failCall(c *C, contractABI abi.ABI, method string, args []interface{}, err error) {
+	input, packErr := contractABI.Pack(method, args...)
+	c.Assert(packErr, IsNil)
+	b.errs[string(input)] = err
+}
+
+func (b *testBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if err, exists := b.errs[string(call.Data)]; exists {
+		return nil, err
+	}
+	output, exists := b.results[string(call.Data)]
+	if !exists {
+		return nil, errors.New("VM Exception while processing transaction: revert")
+	}
+	return output, nil
+}
+
+// FilterLogs only filters on the event signature & the start block.
+func (b *testBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	var logs []types.Log
+	for _, log := range b.logs {
+		if log.Topics[0] == query.Topics[0][0] && log.BlockNumber >= query.FromBlock.Uint64() {
+			logs = append(logs, log)
+		}
+	}
+	return logs, nil
+}
+
+func (s *TokenContractTestSuite) TestOwnerOfAndTokensOf(c *C) {
+	owner := common.HexToAddress("0x5194b63f10691e46635b27925100cfc0a5ceca62")
+	cardsABI, err := abi.JSON(strings.NewReader(ethcontract.CardsABI))
+	c.Assert(err, IsNil)
+	backend := newTestBackend()
+	backend.expectCall(c, cardsABI, "ownerOf", []interface{}{big.NewInt(26)}, owner)
+	backend.expectCall(c, cardsABI, "balanceOf", []interface{}{owner}, big.NewInt(2))
+	backend.expectCall(c, cardsABI, "tokenOfOwnerByIndex", []interface{}{owner, big.NewInt(0)}, big.NewInt(26))
+	backend.expectCall(c, cardsABI, "tokenOfOwnerByIndex", []interface{}{owner, big.NewInt(1)}, big.NewInt(30))
+
+	cards, err := ethcontract.NewCards(common.HexToAddress("0x01"), backend)
+	c.Assert(err, IsNil)
+	erc721, err := ethcontract.NewERC721(common.HexToAddress("0x01"), backend)
+	c.Assert(err, IsNil)
+
+	for _, token := range []TokenContract{&TContract{tokenContract: cards}, &ERC721Contract{contract: erc721}} {
+		tokenOwner, err := token.OwnerOf(context.Background(), big.NewInt(26))
+		c.Assert(err, IsNil)
+		c.Assert(tokenOwner, Equals, owner)
+
+		tokens, err := token.TokensOf(context.Background(), owner)
+		c.Assert(err, IsNil)
+		c.Assert(tokens, DeepEquals, []*big.Int{big.NewInt(26), big.NewInt(30)})
+
+		_, err = token.OwnerOf(context.Background(), big.NewInt(27))
+		c.Assert(err, NotNil)
+	}
+}
@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// txOptions contains the settings of a single tx, any settings that aren't set fall back to the
+// defaults of the transactor the tx is sent with.
+type txOptions struct {
+	Context  context.Context
+	Value    *big.Int
+	GasPrice *big.Int
+	GasLimit uint64
+	// Explicit nonce, if nil the next nonce is obtained from the transactor's NonceManager.
+	Nonce *uint64
+}
+
+// transactor sends txs from a single Ethereum account. Each tx gets its own bind.TransactOpts so a
+// transactor can be safely used from multiple goroutines.
+type transactor struct {
+	// Default settings for all txs, must never be modified once the transactor is created.
+	defaults *bind.TransactOpts
+	nonces   *NonceManager
+}
+
+// newTransactor creates a transactor that sends txs from the account of the given key. Wrappers of
+// different contracts that send txs from the same account should share the nonce manager, if nonces
+// is nil the transactor gets its own.
+func newTransactor(backend Backend, key *ecdsa.PrivateKey, ethCfg *EthConfig, nonces *NonceManager) *transactor {
+	if nonces == nil {
+		nonces = NewNonceManager(backend, crypto.PubkeyToAddress(key.PublicKey))
+	}
+	return &transactor{
+		defaults: ethCfg.NewTransactor(key),
+		nonces:   nonces,
+	}
+}
+
+// transactOpts creates the bind.TransactOpts for a single tx.
+func (t *transactor) transactOpts(opts *txOptions, nonce uint64) *bind.TransactOpts {
+	auth := &bind.TransactOpts{
+		From:     t.defaults.From,
+		Signer:   t.defaults.Signer,
+		Nonce:    new(big.Int).SetUint64(nonce),
+		Value:    opts.Value,
+		GasPrice: t.defaults.GasPrice,
+		GasLimit: t.defaults.GasLimit,
+		Context:  opts.Context,
+	}
+	if opts.GasPrice != nil {
+		auth.GasPrice = opts.GasPrice
+	}
+	if opts.GasLimit != 0 {
+		auth.GasLimit = opts.GasLimit
+	}
+	return auth
+}
+
+// transact sends a tx using the given function, and returns the hash of the tx.
+func (t *transactor) transact(
+	opts *txOptions, send func(*bind.TransactOpts) (*types.Transaction, error),
+) (common.Hash, error) {
+	if opts == nil {
+		opts = &txOptions{}
+	}
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var txHash common.Hash
+	sendWithNonce := func(nonce uint64) error {
+		tx, err := send(t.transactOpts(opts, nonce))
+		if err != nil {
+			return err
+		}
+		txHash = tx.Hash()
+		return nil
+	}
+	var err error
+	if opts.Nonce != nil {
+		err = sendWithNonce(*opts.Nonce)
+	} else {
+		err = t.nonces.Send(ctx, sendWithNonce)
+	}
+	return txHash, err
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	// How often WaitMined polls for the receipt of a tx.
+	ReceiptPollInterval = 1 * time.Second
+	// How long WaitMined waits for a tx to be mined before giving up, unless the context passed to
+	// WaitMined has its own deadline.
+	ReceiptTimeout = 2 * time.Minute
+)
+
+var (
+	ErrTxReverted       = errors.New("tx reverted")
+	ErrTxReceiptTimeout = errors.New("timed out waiting for tx receipt")
+)
+
+// RootChainEvent is an event emitted by the RootChain contract.
+type RootChainEvent struct {
+	// Name of the event, as declared in RootChain.sol.
+	Name string
+	Log  *types.Log
+}
+
+// TxReceipt is the receipt of a mined tx.
+type TxReceipt struct {
+	TxHash   common.Hash
+	GasUsed  uint64
+	Reverted bool
+	// All the logs emitted by the tx.
+	Logs []*types.Log
+	// The events emitted by the RootChain contract (in the order they were emitted).
+	Events []*RootChainEvent
+}
+
+// Event returns the first event with the given name emitted by the tx, or nil if no such event
+// was emitted.
+func (r *TxReceipt) Event(name string) *RootChainEvent {
+	for _, event := range r.Events {
+		if event.Name == name {
+			return event
+		}
+	}
+	return nil
+}
+
+// waitMined polls for the receipt of the given tx until the tx is mined, and returns the receipt
+// along with the RootChain events emitted by the tx. If the tx was reverted the receipt is returned
+// along with ErrTxReverted.
+func waitMined(
+	ctx context.Context, backend ethereum.TransactionReader, rootChainAddr common.Address, txHash common.Hash,
+) (*TxReceipt, error) {
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, ReceiptTimeout)
+		defer cancel()
+	}
+
+	ticker := time.NewTicker(ReceiptPollInterval)
+	defer ticker.Stop()
+
+	for {
+		receipt, err := backend.TransactionReceipt(ctx, txHash)
+		if err != nil && err != ethereum.NotFound {
+			return nil, errors.Wrapf(err, "failed to retrieve receipt of tx %s", txHash.Hex())
+		}
+		if receipt != nil {
+			return newTxReceipt(rootChainAddr, txHash, receipt)
+		}
+		select {
+		case <-ctx.Done():
+			if ctx.Err() == context.DeadlineExceeded {
+				return nil, errors.Wrapf(ErrTxReceiptTimeout, "tx %s", txHash.Hex())
+			}
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func newTxReceipt(rootChainAddr common.Address, txHash common.Hash, receipt *types.Receipt) (*TxReceipt, error) {
+	r := &TxReceipt{
+		TxHash:   txHash,
+		GasUsed:  receipt.GasUsed,
+		Reverted: receipt.Status == types.ReceiptStatusFailed,
+		Logs:     receipt.Logs,
+	}
+	for _, log := range receipt.Logs {
+		if log.Address != rootChainAddr || len(log.Topics) == 0 {
+			continue
+		}
+		for name, event := range rootChainABI.Events {
+			if event.Id() == log.Topics[0] {
+				r.Events = append(r.Events, &RootChainEvent{Name: name, Log: log})
+				break
+			}
+		}
+	}
+	if r.Reverted {
+		return r, errors.Wrapf(ErrTxReverted, "tx %s", txHash.Hex())
+	}
+	return r, nil
+}
@@ -0,0 +1,41 @@
+package client
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	. "gopkg.in/check.v1"
+)
+
+type TxReceiptTestSuite struct{}
+
+var _ = Suite(&TxReceiptTestSuite{})
+
+func (s *TxReceiptTestSuite) TestReceiptEvents(c *C) {
+	rootChainAddr := common.HexToAddress("0x9e51aeeeca736cd81d27e025465834b8ec08628a")
+	otherAddr := common.HexToAddress("0x1aa76056924bf4768d63357eca6d6a56ec929131")
+	finalizedExitID := rootChainABI.Events["FinalizedExit"].Id()
+	freedBondID := rootChainABI.Events["FreedBond"].Id()
+	txHash := common.HexToHash("0x01")
+
+	receipt, err := newTxReceipt(rootChainAddr, txHash, &types.Receipt{
+		Status: types.ReceiptStatusSuccessful,
+		Logs: []*types.Log{
+			{Address: rootChainAddr, Topics: []common.Hash{freedBondID}},
+			// Logs emitted by other contracts should be ignored
+			{Address: otherAddr, Topics: []common.Hash{finalizedExitID}},
+			{Address: rootChainAddr, Topics: []common.Hash{finalizedExitID}},
+		},
+	})
+	c.Assert(err, IsNil)
+	c.Assert(receipt.Reverted, Equals, false)
+	c.Assert(receipt.Logs, HasLen, 3)
+	c.Assert(receipt.Events, HasLen, 2)
+	c.Assert(receipt.Events[0].Name, Equals, "FreedBond")
+	c.Assert(receipt.Event("FinalizedExit").Log.Address, Equals, rootChainAddr)
+	c.Assert(receipt.Event("StartedExit"), IsNil)
+
+	receipt, err = newTxReceipt(rootChainAddr, txHash, &types.Receipt{Status: types.ReceiptStatusFailed})
+	c.Assert(errors.Cause(err), Equals, ErrTxReverted)
+	c.Assert(receipt.Reverted, Equals, true)
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"client"
+	"context"
+	"hostile_operator"
+	"log"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func main() {
+
+	maxIteration := 30
+	sleepPerIteration := 2000 * time.Millisecond
+	blockTimeout := time.Duration(maxIteration) * sleepPerIteration
+
+	ethCfg, err := client.LoadDefaultEthConfig()
+	exitIfError(err)
+	ganache, err := client.ConnectToGanache(ethCfg.EthereumURI)
+	exitIfError(err)
+
+	// Block withholding can only be simulated by the hostile operator
+	testCtx, err := client.SetupTest(ganache, true, "http://localhost:46658/query", "http://localhost:46658/rpc")
+	exitIfError(err)
+
+	charlie := testCtx.Charlie
+	bob := testCtx.Bob
+	authority := testCtx.Authority
+	// The operator must be reset before exiting, even on failure
+	operator := testCtx.HostileOperator
+
+	// Give Charlie 5 tokens
+	operator.ExitIfError(charlie.TokenContract.Register())
+
+	charlieTokensStart, err := charlie.TokenContract.BalanceOf()
+	operator.ExitIfError(err)
+	log.Printf("Charlie has %v tokens", charlieTokensStart)
+	if notEquals(charlieTokensStart, 5) {
+		operator.Fatal("START: Charlie has incorrect number of tokens")
+	}
+	charlieAccount, err := charlie.TokenContract.Account()
+	operator.ExitIfError(err)
+	tokens, err := charlie.TokenContract.TokensOf(context.TODO(), common.HexToAddress(charlieAccount.Address))
+	operator.ExitIfError(err)
+	if len(tokens) == 0 {
+		operator.Fatal("START: Charlie has no tokens")
+	}
+
+	// Charlie deposits a coin, deposit blocks are still served while blocks are being withheld
+	deposit, err := charlie.DepositAndWait(tokens[0])
+	operator.ExitIfError(err)
+
+	currentBlock, err := authority.GetBlockNumber()
+	operator.ExitIfError(err)
+
+	// From now on the operator publishes block roots, but not the blocks themselves
+	operator.ExitIfError(operator.SetMisbehavior(&hostile_operator.Misbehavior{WithholdBlocks: true}))
+
+	// Charlie sends the coin to Bob
+	bobAccount, err := bob.TokenContract.Account()
+	operator.ExitIfError(err)
+	err = charlie.SendTransaction(deposit.Slot, deposit.BlockNum, big.NewInt(1), bobAccount.Address)
+	operator.ExitIfError(err)
+
+	withheldBlock, err := client.WaitForBlockChange(authority, currentBlock, blockTimeout)
+	operator.ExitIfError(err)
+
+	// Bob can't verify the transfer, so the coin isn't accepted
+	if _, err := bob.GetBlock(withheldBlock); err == nil {
+		operator.Fatalf("Operator served withheld block %v", withheldBlock)
+	}
+	log.Printf("Block %v is unavailable, Bob rejects the coin", withheldBlock)
+	// The withheld block stays unavailable, but there's no need to withhold any more blocks
+	operator.ExitIfError(operator.SetMisbehavior(&hostile_operator.Misbehavior{}))
+
+	// Charlie can't tell if the transfer was included in the withheld block, so the coin is exited
+	// from the deposit before the operator can get up to any more mischief
+	_, err = charlie.StartExit(deposit.Slot, big.NewInt(0), deposit.BlockNum)
+	operator.ExitIfError(err)
+
+	// After 8 days pass,
+	_, err = ganache.IncreaseTime(context.TODO(), 8*24*3600)
+	operator.ExitIfError(err)
+
+	operator.ExitIfError(authority.FinalizeExit(deposit.Slot))
+	operator.ExitIfError(charlie.Withdraw(deposit.Slot))
+	operator.ExitIfError(charlie.WithdrawBonds())
+
+	charlieTokensEnd, err := charlie.TokenContract.BalanceOf()
+	operator.ExitIfError(err)
+	log.Printf("Charlie has %v tokens", charlieTokensEnd)
+	if notEquals(charlieTokensEnd, 5) {
+		operator.Fatal("END: Charlie has incorrect number of tokens")
+	}
+
+	log.Printf("Plasma Cash block withholding success :)")
+}
+
+// not idiomatic go, but it cleans up this sample
+func exitIfError(err error) {
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func notEquals(x *big.Int, y int64) bool {
+	if x.Cmp(big.NewInt(y)) != 0 {
+		return true
+	} else {
+		return false
+	}
+}
@@ -16,6 +16,7 @@ func main() {
 
 	maxIteration := 30
 	sleepPerIteration := 2000 * time.Millisecond
+	blockTimeout := time.Duration(maxIteration) * sleepPerIteration
 
 	var hostile bool
 	flag.BoolVar(&hostile, "hostile", false, "run the demo with a hostile Plasma Cash operator")
@@ -25,12 +26,12 @@ func main() {
 		log.Println("Testing with a hostile Plasma Cash operator")
 	}
 
-	client.InitClients("http://localhost:8545")
-	client.InitTokenClient("http://localhost:8545")
-	ganache, err := client.ConnectToGanache("http://localhost:8545")
+	ethCfg, err := client.LoadDefaultEthConfig()
+	exitIfError(err)
+	ganache, err := client.ConnectToGanache(ethCfg.EthereumURI)
 	exitIfError(err)
 
-	testCtx, err := client.SetupTest(hostile, "http://localhost:46658/query", "http://localhost:46658/rpc")
+	testCtx, err := client.SetupTest(ganache, hostile, "http://localhost:46658/query", "http://localhost:46658/rpc")
 	if err != nil {
 		panic(err)
 	}
@@ -62,25 +63,12 @@ func main() {
 	exitIfError(err)
 
 	// Mallory deposits one of her coins to the plasma contract
-	txHash := mallory.Deposit(big.NewInt(6))
-	currentBlock, err = client.PollForBlockChange(authority, currentBlock, maxIteration, sleepPerIteration)
-	if err != nil {
-		panic(err)
-	}
-
-	depEvent, err := mallory.RootChain.DepositEventData(txHash)
+	depEvent, err := mallory.DepositAndWait(big.NewInt(6))
 	exitIfError(err)
 	depositSlot1 := depEvent.Slot
 	slots = append(slots, depEvent.Slot)
 
-	txHash = mallory.Deposit(big.NewInt(7))
-
-	currentBlock, err = client.PollForBlockChange(authority, currentBlock, maxIteration, sleepPerIteration)
-	if err != nil {
-		panic(err)
-	}
-
-	depEvent, err = mallory.RootChain.DepositEventData(txHash)
+	depEvent, err = mallory.DepositAndWait(big.NewInt(7))
 	exitIfError(err)
 	slots = append(slots, depEvent.Slot)
 
@@ -108,7 +96,7 @@ func main() {
 	err = mallory.SendTransaction(depositSlot1, coin.DepositBlockNum, big.NewInt(1), danAccount.Address) //mallory_to_dan
 	exitIfError(err)
 
-	currentBlock, err = client.PollForBlockChange(authority, currentBlock, maxIteration, sleepPerIteration)
+	currentBlock, err = client.WaitForBlockChange(authority, currentBlock, blockTimeout)
 	if err != nil {
 		panic(err)
 	}
@@ -117,12 +105,25 @@ func main() {
 	plasmaBlock3, err := authority.GetBlockNumber()
 	exitIfError(err)
 
+	// Dan's client guards the coin he received
+	danGuardian := client.NewGuardian(dan)
+	exitIfError(danGuardian.Track(depositSlot1))
+
 	// Mallory attempts to exit spent coin (the one sent to Dan)
 	log.Printf("Mallory trying an exit %d on block number %d\n", depositSlot1, coin.DepositBlockNum)
 	mallory.StartExit(depositSlot1, big.NewInt(0), coin.DepositBlockNum)
 
-	// Dan's transaction depositSlot1 included in plasmaBlock3. He challenges!
-	dan.ChallengeAfter(depositSlot1, plasmaBlock3)
+	// Dan's transaction depositSlot1 included in plasmaBlock3. His client challenges!
+	select {
+	case challenge := <-danGuardian.Challenges():
+		exitIfError(challenge.Err)
+		if challenge.Type != client.ChallengeAfterType || challenge.BlockNum.Cmp(plasmaBlock3) != 0 {
+			log.Fatalf("Dan's client submitted %v with block %v", challenge.Type, challenge.BlockNum)
+		}
+	case <-time.After(time.Duration(maxIteration) * sleepPerIteration):
+		log.Fatal("Dan's client didn't challenge Mallory's exit")
+	}
+	danGuardian.Stop()
 	dan.StartExit(depositSlot1, coin.DepositBlockNum, plasmaBlock3)
 
 	// After 8 days pass,
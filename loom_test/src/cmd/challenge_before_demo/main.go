@@ -5,6 +5,7 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"hostile_operator"
 	"log"
 	"math/big"
 	"time"
@@ -16,6 +17,7 @@ func main() {
 
 	maxIteration := 30
 	sleepPerIteration := 2000 * time.Millisecond
+	blockTimeout := time.Duration(maxIteration) * sleepPerIteration
 
 	var hostile bool
 	flag.BoolVar(&hostile, "hostile", false, "run the demo with a hostile Plasma Cash operator")
@@ -25,12 +27,12 @@ func main() {
 		log.Println("Testing with a hostile Plasma Cash operator")
 	}
 
-	client.InitClients("http://localhost:8545")
-	client.InitTokenClient("http://localhost:8545")
-	ganache, err := client.ConnectToGanache("http://localhost:8545")
+	ethCfg, err := client.LoadDefaultEthConfig()
+	exitIfError(err)
+	ganache, err := client.ConnectToGanache(ethCfg.EthereumURI)
 	exitIfError(err)
 
-	testCtx, err := client.SetupTest(hostile, "http://localhost:46658/query", "http://localhost:46658/rpc")
+	testCtx, err := client.SetupTest(ganache, hostile, "http://localhost:46658/query", "http://localhost:46658/rpc")
 	exitIfError(err)
 
 	dan := testCtx.Dan
@@ -57,13 +59,9 @@ func main() {
 	// Dan deposits a coin
 	currentBlock, err := authority.GetBlockNumber()
 	exitIfError(err)
-	txHash := dan.Deposit(big.NewInt(16))
-	currentBlock, err = client.PollForBlockChange(authority, currentBlock, maxIteration, sleepPerIteration)
-	if err != nil {
-		panic(err)
-	}
-
-	depEvent, err := mallory.RootChain.DepositEventData(txHash)
+	depEvent, err := dan.DepositAndWait(big.NewInt(16))
+	exitIfError(err)
+	currentBlock, err = authority.GetBlockNumber()
 	exitIfError(err)
 	depositSlot1 := depEvent.Slot
 
@@ -73,11 +71,20 @@ func main() {
 	coin, err := dan.RootChain.PlasmaCoin(depositSlot1)
 	exitIfError(err)
 
-	// TODO: Dan should start watching for exits of depositSlot1
+	danGuardian := client.NewGuardian(dan)
+	exitIfError(danGuardian.Track(depositSlot1))
 
-	// Trudy sends her invalid coin (which she doesn't own) to Mallory
-	exitIfError(trudy.SendTransaction(depositSlot1, coin.DepositBlockNum, big.NewInt(1), malloryAccount.Address))
-	currentBlock, err = client.PollForBlockChange(authority, currentBlock, maxIteration, sleepPerIteration)
+	// Trudy sends her invalid coin (which she doesn't own) to Mallory, the hostile operator only
+	// accepts the transfer while it's skipping owner checks
+	if hostile {
+		exitIfError(testCtx.HostileOperator.SetMisbehavior(&hostile_operator.Misbehavior{SkipOwnerChecks: true}))
+	}
+	err = trudy.SendTransaction(depositSlot1, coin.DepositBlockNum, big.NewInt(1), malloryAccount.Address)
+	if hostile {
+		exitIfError(testCtx.HostileOperator.SetMisbehavior(&hostile_operator.Misbehavior{}))
+	}
+	exitIfError(err)
+	currentBlock, err = client.WaitForBlockChange(authority, currentBlock, blockTimeout)
 	if err != nil {
 		panic(err)
 	}
@@ -86,7 +93,7 @@ func main() {
 
 	// Mallory sends the invalid coin back to Trudy
 	exitIfError(mallory.SendTransaction(depositSlot1, trudyToMalloryBlockNum, big.NewInt(1), trudyAccount.Address))
-	currentBlock, err = client.PollForBlockChange(authority, currentBlock, maxIteration, sleepPerIteration)
+	currentBlock, err = client.WaitForBlockChange(authority, currentBlock, blockTimeout)
 	if err != nil {
 		panic(err)
 	}
@@ -98,11 +105,16 @@ func main() {
 	_, err = trudy.StartExit(depositSlot1, trudyToMalloryBlockNum, malloryToTrudyBlockNum)
 	exitIfError(err)
 
-	fmt.Println("Dan attempts to challenge...")
-	// Dan challenges Trudy's exit (in practice this will be done automatically by Dan's client
-	// (once watching is implemented)
-	_, err = dan.ChallengeBefore(depositSlot1, coin.DepositBlockNum)
-	exitIfError(err)
+	fmt.Println("Dan's client challenges...")
+	select {
+	case challenge := <-danGuardian.Challenges():
+		exitIfError(challenge.Err)
+		if challenge.Type != client.ChallengeBeforeType || challenge.BlockNum.Cmp(coin.DepositBlockNum) != 0 {
+			log.Fatalf("Dan's client submitted %v with block %v", challenge.Type, challenge.BlockNum)
+		}
+	case <-time.After(time.Duration(maxIteration) * sleepPerIteration):
+		log.Fatal("Dan's client didn't challenge Trudy's exit")
+	}
 
 	// Let 8 days pass without any response to the challenge
 	_, err = ganache.IncreaseTime(context.TODO(), 8*24*3600)
@@ -116,7 +128,7 @@ func main() {
 	_, err = dan.StartExit(depositSlot1, big.NewInt(0), coin.DepositBlockNum)
 	exitIfError(err)
 
-	// TODO: Dan should stop watching exits of depositSlot1
+	danGuardian.Stop()
 
 	// Jump forward in time by another 8 days
 	_, err = ganache.IncreaseTime(context.TODO(), 8*24*3600)
@@ -5,6 +5,7 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"hostile_operator"
 	"log"
 	"math/big"
 	"time"
@@ -16,6 +17,7 @@ func main() {
 
 	maxIteration := 30
 	sleepPerIteration := 2000 * time.Millisecond
+	blockTimeout := time.Duration(maxIteration) * sleepPerIteration
 
 	var hostile bool
 	flag.BoolVar(&hostile, "hostile", false, "run the demo with a hostile Plasma Cash operator")
@@ -25,12 +27,12 @@ func main() {
 		log.Println("Testing with a hostile Plasma Cash operator")
 	}
 
-	client.InitClients("http://localhost:8545")
-	client.InitTokenClient("http://localhost:8545")
-	ganache, err := client.ConnectToGanache("http://localhost:8545")
+	ethCfg, err := client.LoadDefaultEthConfig()
+	exitIfError(err)
+	ganache, err := client.ConnectToGanache(ethCfg.EthereumURI)
 	exitIfError(err)
 
-	testCtx, err := client.SetupTest(hostile, "http://localhost:46658/query", "http://localhost:46658/rpc")
+	testCtx, err := client.SetupTest(ganache, hostile, "http://localhost:46658/query", "http://localhost:46658/rpc")
 	exitIfError(err)
 
 	alice := testCtx.Alice
@@ -53,16 +55,11 @@ func main() {
 	exitIfError(err)
 
 	// Eve deposits a coin
-	txHash := eve.Deposit(big.NewInt(11))
+	deposit1, err := eve.DepositAndWait(big.NewInt(11))
+	exitIfError(err)
 
 	currentBlock, err := authority.GetBlockNumber()
 	exitIfError(err)
-	currentBlock, err = client.PollForBlockChange(authority, currentBlock, maxIteration, sleepPerIteration)
-	if err != nil {
-		panic(err)
-	}
-	deposit1, err := eve.RootChain.DepositEventData(txHash)
-	exitIfError(err)
 
 	// Eve sends her plasma coin to Bob
 	coin, err := eve.PlasmaCoin(deposit1.Slot)
@@ -70,7 +67,7 @@ func main() {
 	err = eve.SendTransaction(deposit1.Slot, coin.DepositBlockNum, big.NewInt(1), bobAccount.Address)
 	exitIfError(err)
 
-	currentBlock, err = client.PollForBlockChange(authority, currentBlock, maxIteration, sleepPerIteration)
+	currentBlock, err = client.WaitForBlockChange(authority, currentBlock, blockTimeout)
 	if err != nil {
 		panic(err)
 	}
@@ -78,13 +75,21 @@ func main() {
 	eveToBobBlockNum, err := authority.GetBlockNumber()
 	exitIfError(err)
 
-	// TODO: bob.WatchExits(deposit1.Slot)
+	bobGuardian := client.NewGuardian(bob)
+	exitIfError(bobGuardian.Track(deposit1.Slot))
 
-	// Eve sends this same plasma coin to Alice
+	// Eve sends this same plasma coin to Alice, the hostile operator only accepts the double spend
+	// while it's skipping latest block checks
+	if hostile {
+		exitIfError(testCtx.HostileOperator.SetMisbehavior(&hostile_operator.Misbehavior{SkipLatestBlockChecks: true}))
+	}
 	err = eve.SendTransaction(deposit1.Slot, coin.DepositBlockNum, big.NewInt(1), aliceAccount.Address)
+	if hostile {
+		exitIfError(testCtx.HostileOperator.SetMisbehavior(&hostile_operator.Misbehavior{}))
+	}
 	exitIfError(err)
 
-	currentBlock, err = client.PollForBlockChange(authority, currentBlock, maxIteration, sleepPerIteration)
+	currentBlock, err = client.WaitForBlockChange(authority, currentBlock, blockTimeout)
 	if err != nil {
 		panic(err)
 	}
@@ -98,18 +103,24 @@ func main() {
 	_, err = alice.StartExit(deposit1.Slot, coin.DepositBlockNum, eveToAliceBlock)
 	exitIfError(err)
 
-	fmt.Printf("Bob is challenging slot %v at block %v\n", deposit1.Slot, eveToBobBlockNum)
-	// Alice's exit should be auto-challenged by Bob's client, but watching/auto-challenge hasn't
-	// been implemented yet, so challenge the exit manually for now...
-	_, err = bob.ChallengeBetween(deposit1.Slot, eveToBobBlockNum)
-	exitIfError(err)
+	// Alice's exit should be auto-challenged by Bob's client
+	select {
+	case challenge := <-bobGuardian.Challenges():
+		exitIfError(challenge.Err)
+		if challenge.Type != client.ChallengeBetweenType || challenge.BlockNum.Cmp(eveToBobBlockNum) != 0 {
+			log.Fatalf("Bob's client submitted %v with block %v", challenge.Type, challenge.BlockNum)
+		}
+		fmt.Printf("Bob challenged slot %v at block %v\n", deposit1.Slot, challenge.BlockNum)
+	case <-time.After(time.Duration(maxIteration) * sleepPerIteration):
+		log.Fatal("Bob's client didn't challenge Alice's exit")
+	}
 
 	fmt.Printf("Bob attempts to exit slot %v prevBlock %v exitBlock %v\n",
 		deposit1.Slot, coin.DepositBlockNum, eveToBobBlockNum)
 	_, err = bob.StartExit(deposit1.Slot, coin.DepositBlockNum, eveToBobBlockNum)
 	exitIfError(err)
 
-	// TODO: bob.StopWatchingExits(deposit1.Slot)
+	bobGuardian.Stop()
 
 	_, err = ganache.IncreaseTime(context.TODO(), 8*24*3600)
 	exitIfError(err)
@@ -7,12 +7,15 @@ import (
 	"log"
 	"math/big"
 	"time"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 func main() {
 
 	maxIteration := 30
 	sleepPerIteration := 2000 * time.Millisecond
+	blockTimeout := time.Duration(maxIteration) * sleepPerIteration
 
 	var hostile bool
 	flag.BoolVar(&hostile, "hostile", false, "run the demo with a hostile Plasma Cash operator")
@@ -22,12 +25,12 @@ func main() {
 		log.Println("Testing with a hostile Plasma Cash operator")
 	}
 
-	client.InitClients("http://localhost:8545")
-	client.InitTokenClient("http://localhost:8545")
-	ganache, err := client.ConnectToGanache("http://localhost:8545")
+	ethCfg, err := client.LoadDefaultEthConfig()
+	exitIfError(err)
+	ganache, err := client.ConnectToGanache(ethCfg.EthereumURI)
 	exitIfError(err)
 
-	testCtx, err := client.SetupTest(hostile, "http://localhost:46658/query", "http://localhost:46658/rpc")
+	testCtx, err := client.SetupTest(ganache, hostile, "http://localhost:46658/query", "http://localhost:46658/rpc")
 	exitIfError(err)
 
 	alice := testCtx.Alice
@@ -72,37 +75,19 @@ func main() {
 	// Alice deposits 3 of her coins to the plasma contract and gets 3 plasma nft
 	// utxos in return
 	tokenID := big.NewInt(1)
-	txHash := alice.Deposit(tokenID)
-	currentBlock, err = client.PollForBlockChange(authority, currentBlock, maxIteration, sleepPerIteration)
-	if err != nil {
-		panic(err)
-	}
-
-	deposit1, err := alice.RootChain.DepositEventData(txHash)
+	deposit1, err := alice.DepositAndWait(tokenID)
 	exitIfError(err)
 	slots = append(slots, deposit1.Slot)
 	alice.DebugCoinMetaData(slots)
 
-	txHash = alice.Deposit(tokenID.Add(tokenID, big.NewInt(1)))
-
-	currentBlock, err = client.PollForBlockChange(authority, currentBlock, maxIteration, sleepPerIteration)
-	if err != nil {
-		panic(err)
-	}
-
-	deposit2, err := alice.RootChain.DepositEventData(txHash)
+	deposit2, err := alice.DepositAndWait(tokenID.Add(tokenID, big.NewInt(1)))
 	exitIfError(err)
 	slots = append(slots, deposit2.Slot)
 	alice.DebugCoinMetaData(slots)
 
-	txHash = alice.Deposit(tokenID.Add(tokenID, big.NewInt(2)))
-
-	currentBlock, err = client.PollForBlockChange(authority, currentBlock, maxIteration, sleepPerIteration)
-	if err != nil {
-		panic(err)
-	}
-
-	deposit3, err := alice.RootChain.DepositEventData(txHash)
+	deposit3, err := alice.DepositAndWait(tokenID.Add(tokenID, big.NewInt(2)))
+	exitIfError(err)
+	currentBlock, err = authority.GetBlockNumber()
 	exitIfError(err)
 	slots = append(slots, deposit3.Slot)
 	alice.DebugCoinMetaData(slots)
@@ -115,7 +100,7 @@ func main() {
 	err = alice.SendTransaction(deposit2.Slot, deposit2.BlockNum, big.NewInt(1), account.Address) //randomTx
 	exitIfError(err)
 
-	currentBlock, err = client.PollForBlockChange(authority, currentBlock, maxIteration, sleepPerIteration)
+	currentBlock, err = client.WaitForBlockChange(authority, currentBlock, blockTimeout)
 	if err != nil {
 		panic(err)
 	}
@@ -125,7 +110,7 @@ func main() {
 	err = alice.SendTransaction(deposit3.Slot, deposit3.BlockNum, big.NewInt(1), account.Address) //aliceToBob
 	exitIfError(err)
 
-	currentBlock, err = client.PollForBlockChange(authority, currentBlock, maxIteration, sleepPerIteration)
+	currentBlock, err = client.WaitForBlockChange(authority, currentBlock, blockTimeout)
 	if err != nil {
 		panic(err)
 	}
@@ -139,12 +124,33 @@ func main() {
 	err = bob.SendTransaction(deposit3.Slot, blkNum, big.NewInt(1), account.Address) //bobToCharlie
 	exitIfError(err)
 
-	currentBlock, err = client.PollForBlockChange(authority, currentBlock, maxIteration, sleepPerIteration)
+	currentBlock, err = client.WaitForBlockChange(authority, currentBlock, blockTimeout)
 	if err != nil {
 		panic(err)
 	}
 
-	// TODO: verify coin history
+	// Bob hands over the history of the coin to Charlie, who verifies it before accepting the coin
+	bundle, err := bob.ExportCoinHistory(deposit3.Slot)
+	exitIfError(err)
+	bundleBytes, err := bundle.Encode()
+	exitIfError(err)
+	bundle, err = client.DecodeCoinHistoryBundle(bundleBytes)
+	exitIfError(err)
+	// The latest block may take a bit to get submitted to the RootChain
+	var lastTx *client.CoinTx
+	for i := 0; ; i++ {
+		if err = charlie.ImportCoinHistory(bundle); err == nil {
+			lastTx, err = charlie.VerifyCoinHistory(deposit3.Slot)
+		}
+		if err == nil || i >= maxIteration {
+			break
+		}
+		time.Sleep(sleepPerIteration)
+	}
+	exitIfError(err)
+	if lastTx.Owner != common.HexToAddress(account.Address) {
+		log.Fatal("Charlie doesn't own the coin he received")
+	}
 
 	//exitIfError(authority.SubmitBlock())
 	plasmaBlock2, err := authority.GetBlockNumber()
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"client"
+	"context"
+	"fmt"
+	"hostile_operator"
+	"log"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/loomnetwork/go-loom/types"
+)
+
+func main() {
+
+	maxIteration := 30
+	sleepPerIteration := 2000 * time.Millisecond
+	blockTimeout := time.Duration(maxIteration) * sleepPerIteration
+
+	ethCfg, err := client.LoadDefaultEthConfig()
+	exitIfError(err)
+	ganache, err := client.ConnectToGanache(ethCfg.EthereumURI)
+	exitIfError(err)
+
+	// Only the hostile operator can be made to include invalid txs
+	testCtx, err := client.SetupTest(ganache, true, "http://localhost:46658/query", "http://localhost:46658/rpc")
+	exitIfError(err)
+
+	bob := testCtx.Bob
+	trudy := testCtx.Trudy
+	mallory := testCtx.Mallory
+	authority := testCtx.Authority
+	// The operator must be reset before exiting, even on failure
+	operator := testCtx.HostileOperator
+
+	trudyAccount, err := trudy.TokenContract.Account()
+	operator.ExitIfError(err)
+	malloryAccount, err := mallory.TokenContract.Account()
+	operator.ExitIfError(err)
+
+	// Give Bob 5 tokens
+	operator.ExitIfError(bob.TokenContract.Register())
+
+	bobTokensStart, err := bob.TokenContract.BalanceOf()
+	operator.ExitIfError(err)
+	bobAccount, err := bob.TokenContract.Account()
+	operator.ExitIfError(err)
+	tokens, err := bob.TokenContract.TokensOf(context.TODO(), common.HexToAddress(bobAccount.Address))
+	operator.ExitIfError(err)
+	if len(tokens) == 0 {
+		operator.Fatal("START: Bob has no tokens")
+	}
+
+	// Bob deposits a coin
+	deposit, err := bob.DepositAndWait(tokens[0])
+	operator.ExitIfError(err)
+	currentBlock, err := authority.GetBlockNumber()
+	operator.ExitIfError(err)
+
+	bobGuardian := client.NewGuardian(bob)
+	operator.ExitIfError(bobGuardian.Track(deposit.Slot))
+
+	// The operator transfers Bob's coin to Mallory in the next block, without Bob's signature
+	err = operator.SetMisbehavior(&hostile_operator.Misbehavior{
+		StealSlots: []uint64{deposit.Slot},
+		Thief: &types.Address{
+			ChainId: "eth",
+			Local:   common.HexToAddress(malloryAccount.Address).Bytes(),
+		},
+	})
+	operator.ExitIfError(err)
+
+	currentBlock, err = client.WaitForBlockChange(authority, currentBlock, blockTimeout)
+	operator.ExitIfError(err)
+	stolenBlockNum := currentBlock
+	operator.ExitIfError(operator.SetMisbehavior(&hostile_operator.Misbehavior{}))
+
+	// Mallory passes the stolen coin on to Trudy with a valid signature
+	operator.ExitIfError(mallory.SendTransaction(deposit.Slot, stolenBlockNum, big.NewInt(1), trudyAccount.Address))
+	currentBlock, err = client.WaitForBlockChange(authority, currentBlock, blockTimeout)
+	operator.ExitIfError(err)
+	malloryToTrudyBlockNum := currentBlock
+
+	fmt.Println("Trudy attempts to exit...")
+	_, err = trudy.StartExit(deposit.Slot, stolenBlockNum, malloryToTrudyBlockNum)
+	operator.ExitIfError(err)
+
+	// Nobody can respond to a challenge with Bob's deposit, since the operator can't produce Bob's
+	// signature on the stolen transfer
+	fmt.Println("Bob's client challenges...")
+	select {
+	case challenge := <-bobGuardian.Challenges():
+		operator.ExitIfError(challenge.Err)
+		if challenge.Type != client.ChallengeBeforeType || challenge.BlockNum.Cmp(deposit.BlockNum) != 0 {
+			operator.Fatalf("Bob's client submitted %v with block %v", challenge.Type, challenge.BlockNum)
+		}
+	case <-time.After(time.Duration(maxIteration) * sleepPerIteration):
+		operator.Fatal("Bob's client didn't challenge Trudy's exit")
+	}
+
+	// Let 8 days pass without any response to the challenge
+	_, err = ganache.IncreaseTime(context.TODO(), 8*24*3600)
+	operator.ExitIfError(err)
+
+	fmt.Println("Finalizing exits...")
+	operator.ExitIfError(authority.FinalizeExit(deposit.Slot))
+
+	fmt.Println("Bob attempts to exit...")
+	_, err = bob.StartExit(deposit.Slot, big.NewInt(0), deposit.BlockNum)
+	operator.ExitIfError(err)
+
+	bobGuardian.Stop()
+
+	// Jump forward in time by another 8 days
+	_, err = ganache.IncreaseTime(context.TODO(), 8*24*3600)
+	operator.ExitIfError(err)
+
+	operator.ExitIfError(authority.FinalizeExit(deposit.Slot))
+	operator.ExitIfError(bob.Withdraw(deposit.Slot))
+
+	bobTokensEnd, err := bob.TokenContract.BalanceOf()
+	operator.ExitIfError(err)
+	log.Printf("Bob has %v tokens", bobTokensEnd)
+	if bobTokensEnd.Cmp(bobTokensStart) != 0 {
+		operator.Fatal("END: Bob has incorrect number of tokens")
+	}
+
+	log.Printf("Plasma Cash invalid tx inclusion success :)")
+}
+
+// not idiomatic go, but it cleans up this sample
+func exitIfError(err error) {
+	if err != nil {
+		log.Fatal(err)
+	}
+}
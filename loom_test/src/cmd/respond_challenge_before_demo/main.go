@@ -15,6 +15,7 @@ import (
 func main() {
 	maxIteration := 30
 	sleepPerIteration := 2000 * time.Millisecond
+	blockTimeout := time.Duration(maxIteration) * sleepPerIteration
 
 	var hostile bool
 	flag.BoolVar(&hostile, "hostile", false, "run the demo with a hostile Plasma Cash operator")
@@ -24,12 +25,12 @@ func main() {
 		log.Println("Testing with a hostile Plasma Cash operator")
 	}
 
-	client.InitClients("http://localhost:8545")
-	client.InitTokenClient("http://localhost:8545")
-	ganache, err := client.ConnectToGanache("http://localhost:8545")
+	ethCfg, err := client.LoadDefaultEthConfig()
+	exitIfError(err)
+	ganache, err := client.ConnectToGanache(ethCfg.EthereumURI)
 	exitIfError(err)
 
-	testCtx, err := client.SetupTest(hostile, "http://localhost:46658/query", "http://localhost:46658/rpc")
+	testCtx, err := client.SetupTest(ganache, hostile, "http://localhost:46658/query", "http://localhost:46658/rpc")
 	exitIfError(err)
 
 	dan := testCtx.Dan
@@ -51,13 +52,9 @@ func main() {
 	// Trudy deposits a coin
 	currentBlock, err := authority.GetBlockNumber()
 	exitIfError(err)
-	txHash := trudy.Deposit(big.NewInt(21))
-	currentBlock, err = client.PollForBlockChange(authority, currentBlock, maxIteration, sleepPerIteration)
-	if err != nil {
-		panic(err)
-	}
-
-	depEvent, err := trudy.RootChain.DepositEventData(txHash)
+	depEvent, err := trudy.DepositAndWait(big.NewInt(21))
+	exitIfError(err)
+	currentBlock, err = authority.GetBlockNumber()
 	exitIfError(err)
 	depositSlot1 := depEvent.Slot
 
@@ -67,30 +64,37 @@ func main() {
 	coin, err := trudy.RootChain.PlasmaCoin(depositSlot1)
 	exitIfError(err)
 
-	// TODO: Trudy should start watching for exits of depositSlot1
+	_, err = trudy.WatchExits(depositSlot1)
+	exitIfError(err)
 
 	// Trudy sends her coin to Dan
 	err = trudy.SendTransaction(depositSlot1, coin.DepositBlockNum, big.NewInt(1), danAccount.Address)
 	exitIfError(err)
 
-	currentBlock, err = client.PollForBlockChange(authority, currentBlock, maxIteration, sleepPerIteration)
+	currentBlock, err = client.WaitForBlockChange(authority, currentBlock, blockTimeout)
 	if err != nil {
 		panic(err)
 	}
 	trudyToDanBlockNum, err := authority.GetBlockNumber()
 	exitIfError(err)
 
-	// TODO: Trudy should stop watching for exits of depositSlot1
+	exitIfError(trudy.StopWatchingExits(depositSlot1))
 
 	coin, err = dan.RootChain.PlasmaCoin(depositSlot1)
 	exitIfError(err)
+	danExits, err := dan.WatchExits(depositSlot1)
+	exitIfError(err)
+	danChallenges, err := dan.WatchChallenges(depositSlot1)
+	exitIfError(err)
 	fmt.Println("Dan attempts to exit...")
 	_, err = dan.StartExit(depositSlot1, coin.DepositBlockNum, trudyToDanBlockNum)
 	exitIfError(err)
-	time.Sleep(2 * time.Second)
-
-	// TODO: Dan should start watching for exits of depositSlot1
-	// TODO: Dan should start watching for challenges of depositSlot1
+	select {
+	case exit := <-danExits:
+		fmt.Printf("Dan's client saw the exit of slot %d by %v\n", exit.Slot, exit.Owner.Hex())
+	case <-time.After(time.Duration(maxIteration) * sleepPerIteration):
+		log.Fatal("Dan's client didn't see the exit")
+	}
 
 	fmt.Println("Trudy attempts to challenge Dan's exit...")
 	challengeTxHash, err := trudy.ChallengeBefore(depositSlot1, coin.DepositBlockNum)
@@ -98,12 +102,22 @@ func main() {
 
 	challengedExitEvent, err := trudy.RootChain.ChallengedExitEventData(common.BytesToHash(challengeTxHash))
 	exitIfError(err)
-	challengingTxHash := challengedExitEvent.TxHash
 
-	// TODO: Response should be automatic as long as the client is watching for challenges
-	fmt.Println("Dan responds to the invalid challenge...")
-	_, err = dan.RespondChallengeBefore(depositSlot1, trudyToDanBlockNum, challengingTxHash)
-	exitIfError(err)
+	// Dan's client responds to the invalid challenge automatically
+	select {
+	case challenge := <-danChallenges:
+		exitIfError(challenge.ResponseErr)
+		if challenge.TxHash != challengedExitEvent.TxHash {
+			log.Fatal("Dan's client saw the wrong challenge")
+		}
+		if challenge.RespondingBlockNumber == nil || challenge.RespondingBlockNumber.Cmp(trudyToDanBlockNum) != 0 {
+			log.Fatalf("Dan's client responded with block %v", challenge.RespondingBlockNumber)
+		}
+		fmt.Println("Dan's client responded to the invalid challenge")
+	case <-time.After(time.Duration(maxIteration) * sleepPerIteration):
+		log.Fatal("Dan's client didn't respond to the challenge")
+	}
+	exitIfError(dan.StopWatchingChallenges(depositSlot1))
 
 	// Jump forward in time by 8 days
 	_, err = ganache.IncreaseTime(context.TODO(), 8*24*3600)
@@ -111,6 +125,7 @@ func main() {
 
 	fmt.Println("Finalizing exits...")
 	exitIfError(authority.FinalizeExit(depositSlot1))
+	exitIfError(dan.StopWatchingExits(depositSlot1))
 
 	fmt.Println("Dan withdraws his coin...")
 	exitIfError(dan.Withdraw(depositSlot1))
@@ -7,7 +7,6 @@ import (
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/crypto/sha3"
-	"github.com/ethereum/go-ethereum/rlp"
 	loom "github.com/loomnetwork/go-loom"
 	pctypes "github.com/loomnetwork/go-loom/builtin/types/plasma_cash"
 	"github.com/loomnetwork/go-loom/common"
@@ -18,10 +17,11 @@ import (
 	"github.com/loomnetwork/go-loom/util"
 	"github.com/loomnetwork/mamamerkle"
 	"github.com/pkg/errors"
+
+	"txvalidation"
 )
 
 type (
-	InitRequest                  = pctypes.PlasmaCashInitRequest
 	SubmitBlockToMainnetRequest  = pctypes.SubmitBlockToMainnetRequest
 	SubmitBlockToMainnetResponse = pctypes.SubmitBlockToMainnetResponse
 	Coin                         = pctypes.PlasmaCashCoin
@@ -58,6 +58,9 @@ type (
 // entities to double spend Plasma coins. This is useful to verify that clients can challenge
 // invalid transfers of coins. A real Plasma Cash operator would never allow such coin transfers to
 // go through in the first place.
+//
+// Further attacks can be turned on via the Misbehavior profile in the genesis InitRequest, or by
+// calling SetMisbehavior.
 type HostileOperator struct {
 }
 
@@ -71,6 +74,7 @@ const (
 var (
 	blockHeightKey    = []byte("pcash_height")
 	pendingTXsKey     = []byte("pcash_pending")
+	misbehaviorKey    = []byte("misbehavior")
 	accountKeyPrefix  = []byte("account")
 	plasmaMerkleTopic = "pcash_mainnet_merkle"
 )
@@ -93,6 +97,25 @@ func blockKey(height common.BigUInt) []byte {
 	return util.PrefixKey([]byte("pcash_block_"), []byte(height.String()))
 }
 
+// Key of the latest block that includes a tx for the given slot
+func slotBlockKey(slot uint64) []byte {
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.BigEndian, slot)
+	return util.PrefixKey([]byte("slot_block"), buf.Bytes())
+}
+
+// Key of the account that deposited the coin in the given slot, the slot is listed in this account
+// until the coin is withdrawn.
+func depositorKey(slot uint64) []byte {
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.BigEndian, slot)
+	return util.PrefixKey([]byte("depositor"), buf.Bytes())
+}
+
+func withheldBlockKey(height common.BigUInt) []byte {
+	return util.PrefixKey([]byte("withheld_block_"), []byte(height.String()))
+}
+
 func (c *HostileOperator) Meta() (plugin.Meta, error) {
 	return plugin.Meta{
 		Name:    "hostileoperator",
@@ -118,9 +141,37 @@ func (c *HostileOperator) Init(ctx contract.Context, req *InitRequest) error {
 		Value: *loom.NewBigUIntFromInt(0),
 	}})
 
+	if req.Misbehavior != nil {
+		return ctx.Set(misbehaviorKey, req.Misbehavior)
+	}
 	return nil
 }
 
+// SetMisbehavior replaces the current misbehavior profile of the operator, an empty profile turns
+// off all the optional attacks. Anyone can call this, it's a test contract after all.
+func (c *HostileOperator) SetMisbehavior(ctx contract.Context, req *SetMisbehaviorRequest) error {
+	if req.Misbehavior == nil {
+		return ctx.Set(misbehaviorKey, &Misbehavior{})
+	}
+	return ctx.Set(misbehaviorKey, req.Misbehavior)
+}
+
+func (c *HostileOperator) GetMisbehavior(ctx contract.StaticContext, req *GetMisbehaviorRequest) (*GetMisbehaviorResponse, error) {
+	misbehavior, err := loadMisbehavior(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &GetMisbehaviorResponse{Misbehavior: misbehavior}, nil
+}
+
+func loadMisbehavior(ctx contract.StaticContext) (*Misbehavior, error) {
+	misbehavior := &Misbehavior{}
+	if err := ctx.Get(misbehaviorKey, misbehavior); err != nil && err != contract.ErrNotFound {
+		return nil, errors.Wrap(err, "failed to load misbehavior profile")
+	}
+	return misbehavior, nil
+}
+
 func round(num, near int64) int64 {
 	if num == 0 {
 		return near
@@ -249,6 +300,16 @@ func (c *HostileOperator) SubmitBlockToMainnet(ctx contract.Context, req *Submit
 	pending := &PendingTxs{}
 	ctx.Get(pendingTXsKey, pending)
 
+	misbehavior, err := loadMisbehavior(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(misbehavior.StealSlots) > 0 && misbehavior.Thief != nil {
+		if err := c.stealCoins(ctx, misbehavior, pending); err != nil {
+			return nil, err
+		}
+	}
+
 	leaves := make(map[uint64][]byte)
 	if len(pending.Transactions) == 0 {
 		ctx.Logger().Warn("No pending transaction, returning")
@@ -266,7 +327,7 @@ func (c *HostileOperator) SubmitBlockToMainnet(ctx contract.Context, req *Submit
 			}
 			v.MerkleHash = hash
 		} else {
-			hash, err := rlpEncodeWithSha3(v)
+			hash, err := txvalidation.Hash(v)
 			if err != nil {
 				return nil, err
 			}
@@ -297,6 +358,20 @@ func (c *HostileOperator) SubmitBlockToMainnet(ctx contract.Context, req *Submit
 		return nil, err
 	}
 
+	for _, v := range pending.Transactions {
+		if err := ctx.Set(slotBlockKey(v.Slot), pbk.CurrentHeight); err != nil {
+			return nil, err
+		}
+	}
+
+	// The root still gets published to Ethereum, but the contents of the block will never be served
+	if misbehavior.WithholdBlocks {
+		ctx.Logger().Warn(fmt.Sprintf("Withholding block %v", pbk.CurrentHeight.Value.String()))
+		if err := ctx.Set(withheldBlockKey(pbk.CurrentHeight.Value), pbk.CurrentHeight); err != nil {
+			return nil, err
+		}
+	}
+
 	ctx.EmitTopics(merkleHash, plasmaMerkleTopic)
 
 	// Clear out old pending transactions
@@ -309,6 +384,11 @@ func (c *HostileOperator) SubmitBlockToMainnet(ctx contract.Context, req *Submit
 }
 
 func (c *HostileOperator) PlasmaTxRequest(ctx contract.Context, req *PlasmaTxRequest) error {
+	misbehavior, err := loadMisbehavior(ctx)
+	if err != nil {
+		return err
+	}
+
 	pending := &PendingTxs{}
 	ctx.Get(pendingTXsKey, pending)
 	for _, v := range pending.Transactions {
@@ -316,6 +396,24 @@ func (c *HostileOperator) PlasmaTxRequest(ctx contract.Context, req *PlasmaTxReq
 			return fmt.Errorf("Error appending plasma transaction with existing slot -%d", v.Slot)
 		}
 	}
+
+	state := &operatorState{ctx: ctx}
+	if err := txvalidation.Validate(state, req.Plasmatx, misbehavior.TxChecks()); err != nil {
+		return errors.Wrap(err, "invalid plasma transaction")
+	}
+
+	if len(misbehavior.CensoredOwners) > 0 && req.Plasmatx.PreviousBlock != nil {
+		owner, err := txvalidation.OwnerAt(state, req.Plasmatx.Slot, req.Plasmatx.PreviousBlock)
+		if err != nil {
+			return err
+		}
+		if misbehavior.IsCensored(owner) {
+			return fmt.Errorf("Error appending plasma transaction for slot %d", req.Plasmatx.Slot)
+		}
+	}
+	if misbehavior.ForgeSignatures {
+		req.Plasmatx.Signature = forgeSignature(req.Plasmatx.Signature)
+	}
 	pending.Transactions = append(pending.Transactions, req.Plasmatx)
 
 	return ctx.Set(pendingTXsKey, pending)
@@ -347,6 +445,10 @@ func (c *HostileOperator) depositRequest(ctx contract.Context, req *DepositReque
 	if err != nil {
 		return err
 	}
+	err = ctx.Set(slotBlockKey(req.Slot), req.DepositBlock)
+	if err != nil {
+		return err
+	}
 
 	defaultErrMsg := "[PlasmaCash] failed to process deposit"
 	// Update the sender's local Plasma account to reflect the deposit
@@ -369,6 +471,9 @@ func (c *HostileOperator) depositRequest(ctx contract.Context, req *DepositReque
 	if err = saveAccount(ctx, account); err != nil {
 		return errors.Wrap(err, defaultErrMsg)
 	}
+	if err = ctx.Set(depositorKey(req.Slot), req.From); err != nil {
+		return errors.Wrap(err, defaultErrMsg)
+	}
 
 	if req.DepositBlock.Value.Cmp(&pbk.CurrentHeight.Value) > 0 {
 		pbk.CurrentHeight.Value = req.DepositBlock.Value
@@ -377,6 +482,62 @@ func (c *HostileOperator) depositRequest(ctx contract.Context, req *DepositReque
 	return nil
 }
 
+// stealCoins adds a transfer of each coin in misbehavior.StealSlots to misbehavior.Thief to the
+// pending txs, the transfers are signed with forged signatures. Coins that already have a pending
+// tx are stolen in a later block, each coin is only stolen once. Coins the operator doesn't know
+// about, because they haven't been deposited yet or were already withdrawn, are skipped and dropped
+// from misbehavior.StealSlots, so they can't stop the operator from submitting blocks.
+func (c *HostileOperator) stealCoins(ctx contract.Context, misbehavior *Misbehavior, pending *PendingTxs) error {
+	pendingSlots := make(map[uint64]bool)
+	for _, v := range pending.Transactions {
+		pendingSlots[v.Slot] = true
+	}
+
+	var remaining []uint64
+	for _, slot := range misbehavior.StealSlots {
+		if pendingSlots[slot] {
+			remaining = append(remaining, slot)
+			continue
+		}
+		coin := &Coin{}
+		if err := ctx.Get(coinKey(slot), coin); err != nil {
+			if err == contract.ErrNotFound {
+				ctx.Logger().Warn(fmt.Sprintf("Can't steal unknown coin %v", slot))
+				continue
+			}
+			return errors.Wrapf(err, "failed to load coin %v", slot)
+		}
+		prevBlock := &types.BigUInt{}
+		if err := ctx.Get(slotBlockKey(slot), prevBlock); err != nil {
+			return errors.Wrapf(err, "failed to load latest block of slot %d", slot)
+		}
+		tx := &PlasmaTx{
+			Slot:          slot,
+			PreviousBlock: prevBlock,
+			Denomination:  coin.Token,
+			NewOwner:      misbehavior.Thief,
+		}
+		hash, err := txvalidation.Hash(tx)
+		if err != nil {
+			return err
+		}
+		tx.Signature = forgeSignature(hash)
+		ctx.Logger().Warn(fmt.Sprintf("Stealing coin %v", slot))
+		pending.Transactions = append(pending.Transactions, tx)
+	}
+
+	misbehavior.StealSlots = remaining
+	return ctx.Set(misbehaviorKey, misbehavior)
+}
+
+func loadCoin(ctx contract.StaticContext, slot uint64) (*Coin, error) {
+	coin := &Coin{}
+	if err := ctx.Get(coinKey(slot), coin); err != nil {
+		return nil, errors.Wrapf(err, "failed to load coin %v", slot)
+	}
+	return coin, nil
+}
+
 func saveCoin(ctx contract.Context, coin *Coin) error {
 	if err := ctx.Set(coinKey(coin.Slot), coin); err != nil {
 		return errors.Wrapf(err, "failed to save coin %v", coin.Slot)
@@ -396,8 +557,14 @@ func (c *HostileOperator) GetCurrentBlockRequest(ctx contract.StaticContext, req
 }
 
 func (c *HostileOperator) GetBlockRequest(ctx contract.StaticContext, req *GetBlockRequest) (*GetBlockResponse, error) {
-	pb := &PlasmaBlock{}
+	if req.BlockHeight == nil {
+		return nil, fmt.Errorf("invalid BlockHeight")
+	}
+	if isBlockWithheld(ctx, req.BlockHeight.Value) {
+		return nil, fmt.Errorf("block %v is unavailable", req.BlockHeight.Value.String())
+	}
 
+	pb := &PlasmaBlock{}
 	err := ctx.Get(blockKey(req.BlockHeight.Value), pb)
 	if err != nil {
 		return nil, err
@@ -420,25 +587,109 @@ func (c *HostileOperator) GetUserSlotsRequest(ctx contract.StaticContext, req *G
 	return res, nil
 }
 
-// Dummy method
-func (c *HostileOperator) coinReset(ctc contract.Context, req *CoinResetRequest) error {
-	return nil
+// coinReset moves a coin whose exit was challenged on the RootChain back to DEPOSITED.
+func (c *HostileOperator) coinReset(ctx contract.Context, req *CoinResetRequest) error {
+	ignore, err := ignoreExits(ctx)
+	if err != nil || ignore {
+		return err
+	}
+	coin, err := loadCoinForTransition(ctx, req.Slot)
+	if err != nil || coin == nil {
+		return err
+	}
+	// Failing the request would stall the whole request batch, so bad transitions are skipped
+	if coin.State != CoinState_EXITING {
+		ctx.Logger().Warn(fmt.Sprintf("Ignoring reset of coin %v in state %v", coin.Slot, coin.State))
+		return nil
+	}
+	coin.State = CoinState_DEPOSITED
+	return saveCoin(ctx, coin)
 }
 
-func (c *HostileOperator) exitCoin(ctc contract.Context, req *ExitCoinRequest) error {
-	return nil
+// exitCoin marks a coin as EXITING once its exit has been started on the RootChain.
+func (c *HostileOperator) exitCoin(ctx contract.Context, req *ExitCoinRequest) error {
+	ignore, err := ignoreExits(ctx)
+	if err != nil || ignore {
+		return err
+	}
+	coin, err := loadCoinForTransition(ctx, req.Slot)
+	if err != nil || coin == nil {
+		return err
+	}
+	if coin.State != CoinState_DEPOSITED {
+		ctx.Logger().Warn(fmt.Sprintf("Ignoring exit of coin %v in state %v", coin.Slot, coin.State))
+		return nil
+	}
+	coin.State = CoinState_EXITING
+	return saveCoin(ctx, coin)
 }
 
+// withdrawCoin removes a coin that has been withdrawn from the RootChain from the DAppChain, and
+// from the account of the depositor. The coin may be withdrawn by someone other than the depositor,
+// so the owner in the request doesn't identify the account that lists the slot.
 func (c *HostileOperator) withdrawCoin(ctx contract.Context, req *WithdrawCoinRequest) error {
+	ignore, err := ignoreExits(ctx)
+	if err != nil || ignore {
+		return err
+	}
+	depositor := &types.Address{}
+	if err := ctx.Get(depositorKey(req.Slot), depositor); err != nil {
+		if err != contract.ErrNotFound {
+			return errors.Wrapf(err, "failed to load depositor of withdrawn coin %v", req.Slot)
+		}
+		ctx.Logger().Warn(fmt.Sprintf("Withdrawn coin %v has no depositor", req.Slot))
+	} else {
+		account, err := loadAccount(ctx, loom.UnmarshalAddressPB(depositor))
+		if err != nil {
+			return err
+		}
+		for i, slot := range account.Slots {
+			if slot == req.Slot {
+				account.Slots = append(account.Slots[:i], account.Slots[i+1:]...)
+				if err := saveAccount(ctx, account); err != nil {
+					return errors.Wrapf(err, "failed to save account of withdrawn coin %v", req.Slot)
+				}
+				break
+			}
+		}
+		ctx.Delete(depositorKey(req.Slot))
+	}
+	ctx.Delete(coinKey(req.Slot))
 	return nil
 }
 
+// loadCoinForTransition loads a coin whose state is about to change due to an exit on the
+// RootChain, nil is returned if the operator has no record of the coin.
+func loadCoinForTransition(ctx contract.StaticContext, slot uint64) (*Coin, error) {
+	coin := &Coin{}
+	if err := ctx.Get(coinKey(slot), coin); err != nil {
+		if err == contract.ErrNotFound {
+			ctx.Logger().Warn(fmt.Sprintf("Ignoring exit of unknown coin %v", slot))
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to load coin %v", slot)
+	}
+	return coin, nil
+}
+
+// ignoreExits returns true if the operator should pretend exits never happened.
+func ignoreExits(ctx contract.StaticContext) (bool, error) {
+	misbehavior, err := loadMisbehavior(ctx)
+	if err != nil {
+		return false, err
+	}
+	return misbehavior.IgnoreExits, nil
+}
+
 func (c *HostileOperator) GetPlasmaTxRequest(ctx contract.StaticContext, req *GetPlasmaTxRequest) (*GetPlasmaTxResponse, error) {
 	pb := &PlasmaBlock{}
 
 	if req.BlockHeight == nil {
 		return nil, fmt.Errorf("invalid BlockHeight")
 	}
+	if isBlockWithheld(ctx, req.BlockHeight.Value) {
+		return nil, fmt.Errorf("block %v is unavailable", req.BlockHeight.Value.String())
+	}
 
 	err := ctx.Get(blockKey(req.BlockHeight.Value), pb)
 	if err != nil {
@@ -462,7 +713,14 @@ func (c *HostileOperator) GetPlasmaTxRequest(ctx contract.StaticContext, req *Ge
 		return nil, err
 	}
 
+	misbehavior, err := loadMisbehavior(ctx)
+	if err != nil {
+		return nil, err
+	}
 	tx.Proof = smt.CreateMerkleProof(req.Slot)
+	if misbehavior.ServeWrongProofs {
+		tx.Proof = corruptProof(tx.Proof)
+	}
 
 	res := &GetPlasmaTxResponse{
 		Plasmatx: tx,
@@ -471,6 +729,23 @@ func (c *HostileOperator) GetPlasmaTxRequest(ctx contract.StaticContext, req *Ge
 	return res, nil
 }
 
+// corruptProof returns a copy of the given sparse merkle tree proof that won't verify against the
+// block root. The first sibling included in the proof is altered, if the proof doesn't include any
+// siblings (all of them have the default hash) a bogus sibling is added at the lowest level.
+func corruptProof(proof []byte) []byte {
+	if len(proof) > 8 {
+		corrupted := append([]byte{}, proof...)
+		corrupted[8] ^= 0xff
+		return corrupted
+	}
+	corrupted := make([]byte, 8+32)
+	corrupted[7] = 1
+	for i := 8; i < len(corrupted); i++ {
+		corrupted[i] = 0xff
+	}
+	return corrupted
+}
+
 func loadAccount(ctx contract.StaticContext, owner loom.Address) (*Account, error) {
 	acct := &Account{
 		Owner: owner.MarshalPB(),
@@ -483,32 +758,59 @@ func loadAccount(ctx contract.StaticContext, owner loom.Address) (*Account, erro
 	return acct, nil
 }
 
-func soliditySha3(data uint64) ([]byte, error) {
-	pairs := []*evmcompat.Pair{&evmcompat.Pair{"uint64", strconv.FormatUint(data, 10)}}
-	hash, err := evmcompat.SoliditySHA3(pairs)
-	if err != nil {
-		return []byte{}, err
+// isBlockWithheld returns true if the given block was submitted while block withholding was on.
+func isBlockWithheld(ctx contract.StaticContext, height common.BigUInt) bool {
+	return ctx.Has(withheldBlockKey(height))
+}
+
+// operatorState provides the contract state to txvalidation.
+type operatorState struct {
+	ctx contract.StaticContext
+}
+
+func (s *operatorState) Block(height *types.BigUInt) (*PlasmaBlock, error) {
+	pb := &PlasmaBlock{}
+	if err := s.ctx.Get(blockKey(height.Value), pb); err != nil {
+		return nil, err
 	}
-	return hash, err
+	return pb, nil
 }
 
-func rlpEncodeWithSha3(pb *PlasmaTx) ([]byte, error) {
-	hash, err := rlpEncode(pb)
-	if err != nil {
-		return []byte{}, err
+func (s *operatorState) Coin(slot uint64) (*Coin, error) {
+	return loadCoin(s.ctx, slot)
+}
+
+func (s *operatorState) LatestBlock(slot uint64) (*types.BigUInt, error) {
+	height := &types.BigUInt{}
+	if err := s.ctx.Get(slotBlockKey(slot), height); err != nil {
+		return nil, err
 	}
+	return height, nil
+}
+
+// forgeSignature returns a well-formed signature (a sig type byte followed by a 65 byte ECDSA sig)
+// filled with garbage derived from the given seed, so the forged sig is rejected for being invalid
+// rather than malformed.
+func forgeSignature(seed []byte) []byte {
 	d := sha3.NewKeccak256()
-	d.Write(hash)
-	return d.Sum(nil), nil
+	d.Write(seed)
+	garbage := d.Sum(nil)
+	forged := make([]byte, txvalidation.SignatureLength)
+	// EIP712 sig type
+	forged[0] = 0
+	for i := 1; i < len(forged); i++ {
+		forged[i] = garbage[i%len(garbage)]
+	}
+	return forged
 }
 
-func rlpEncode(pb *PlasmaTx) ([]byte, error) {
-	return rlp.EncodeToBytes([]interface{}{
-		uint64(pb.Slot),
-		pb.PreviousBlock.Value.Bytes(),
-		uint32(pb.Denomination.Value.Int64()),
-		pb.GetNewOwner().Local,
-	})
+func soliditySha3(data uint64) ([]byte, error) {
+	pairs := []*evmcompat.Pair{&evmcompat.Pair{"uint64", strconv.FormatUint(data, 10)}}
+	hash, err := evmcompat.SoliditySHA3(pairs)
+	if err != nil {
+		return []byte{}, err
+	}
+	return hash, err
 }
 
 func isRequestAlreadySeen(meta *pctypes.PlasmaCashEventMeta, currentTally *pctypes.PlasmaCashRequestBatchTally) bool {
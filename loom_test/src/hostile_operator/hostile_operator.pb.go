@@ -0,0 +1,352 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hostile_operator/hostile_operator.proto
+
+package hostile_operator
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import types "github.com/loomnetwork/go-loom/types"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Misbehavior selects the attacks carried out by the HostileOperator, each attack can be turned
+// on independently so that clients can be tested against one attack at a time.
+type Misbehavior struct {
+	// Publish the roots of the blocks submitted while this is set, but refuse to serve their
+	// contents from GetBlockRequest & GetPlasmaTxRequest, even after withholding is turned off.
+	WithholdBlocks bool `protobuf:"varint,1,opt,name=withhold_blocks,json=withholdBlocks,proto3" json:"withhold_blocks,omitempty"`
+	// Serve corrupted proofs from GetPlasmaTxRequest, which won't verify against the block root.
+	ServeWrongProofs bool `protobuf:"varint,2,opt,name=serve_wrong_proofs,json=serveWrongProofs,proto3" json:"serve_wrong_proofs,omitempty"`
+	// Replace the signature of each tx submitted via PlasmaTxRequest with a forged one.
+	ForgeSignatures bool `protobuf:"varint,3,opt,name=forge_signatures,json=forgeSignatures,proto3" json:"forge_signatures,omitempty"`
+	// Reject txs that transfer coins owned by any of these accounts.
+	CensoredOwners []*types.Address `protobuf:"bytes,4,rep,name=censored_owners,json=censoredOwners" json:"censored_owners,omitempty"`
+	// Include a transfer of each of the coins in these slots to thief in the next block, the
+	// transfers are crafted by the operator and signed with forged signatures.
+	StealSlots []uint64       `protobuf:"varint,5,rep,packed,name=steal_slots,json=stealSlots" json:"steal_slots,omitempty"`
+	Thief      *types.Address `protobuf:"bytes,6,opt,name=thief" json:"thief,omitempty"`
+	// The operator performs all the checks an honest operator performs (see txvalidation) on txs
+	// submitted via PlasmaTxRequest, these turn off the individual checks.
+	SkipSignatureChecks bool `protobuf:"varint,7,opt,name=skip_signature_checks,json=skipSignatureChecks,proto3" json:"skip_signature_checks,omitempty"`
+	SkipOwnerChecks     bool `protobuf:"varint,8,opt,name=skip_owner_checks,json=skipOwnerChecks,proto3" json:"skip_owner_checks,omitempty"`
+	SkipCoinStateChecks bool `protobuf:"varint,9,opt,name=skip_coin_state_checks,json=skipCoinStateChecks,proto3" json:"skip_coin_state_checks,omitempty"`
+	// Leave the state of coins untouched when they're exited, reset, or withdrawn on the RootChain,
+	// so the operator keeps treating exited coins as transferable.
+	IgnoreExits bool `protobuf:"varint,10,opt,name=ignore_exits,json=ignoreExits,proto3" json:"ignore_exits,omitempty"`
+	// Accept txs that spend an older block of the coin than the latest one, i.e. double spends.
+	SkipLatestBlockChecks bool     `protobuf:"varint,11,opt,name=skip_latest_block_checks,json=skipLatestBlockChecks,proto3" json:"skip_latest_block_checks,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *Misbehavior) Reset()         { *m = Misbehavior{} }
+func (m *Misbehavior) String() string { return proto.CompactTextString(m) }
+func (*Misbehavior) ProtoMessage()    {}
+func (*Misbehavior) Descriptor() ([]byte, []int) {
+	return fileDescriptor_hostile_operator_8ed0aea0f91d6880, []int{0}
+}
+func (m *Misbehavior) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Misbehavior.Unmarshal(m, b)
+}
+func (m *Misbehavior) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Misbehavior.Marshal(b, m, deterministic)
+}
+func (dst *Misbehavior) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Misbehavior.Merge(dst, src)
+}
+func (m *Misbehavior) XXX_Size() int {
+	return xxx_messageInfo_Misbehavior.Size(m)
+}
+func (m *Misbehavior) XXX_DiscardUnknown() {
+	xxx_messageInfo_Misbehavior.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Misbehavior proto.InternalMessageInfo
+
+func (m *Misbehavior) GetWithholdBlocks() bool {
+	if m != nil {
+		return m.WithholdBlocks
+	}
+	return false
+}
+
+func (m *Misbehavior) GetServeWrongProofs() bool {
+	if m != nil {
+		return m.ServeWrongProofs
+	}
+	return false
+}
+
+func (m *Misbehavior) GetForgeSignatures() bool {
+	if m != nil {
+		return m.ForgeSignatures
+	}
+	return false
+}
+
+func (m *Misbehavior) GetCensoredOwners() []*types.Address {
+	if m != nil {
+		return m.CensoredOwners
+	}
+	return nil
+}
+
+func (m *Misbehavior) GetStealSlots() []uint64 {
+	if m != nil {
+		return m.StealSlots
+	}
+	return nil
+}
+
+func (m *Misbehavior) GetThief() *types.Address {
+	if m != nil {
+		return m.Thief
+	}
+	return nil
+}
+
+func (m *Misbehavior) GetSkipSignatureChecks() bool {
+	if m != nil {
+		return m.SkipSignatureChecks
+	}
+	return false
+}
+
+func (m *Misbehavior) GetSkipOwnerChecks() bool {
+	if m != nil {
+		return m.SkipOwnerChecks
+	}
+	return false
+}
+
+func (m *Misbehavior) GetSkipCoinStateChecks() bool {
+	if m != nil {
+		return m.SkipCoinStateChecks
+	}
+	return false
+}
+
+func (m *Misbehavior) GetIgnoreExits() bool {
+	if m != nil {
+		return m.IgnoreExits
+	}
+	return false
+}
+
+func (m *Misbehavior) GetSkipLatestBlockChecks() bool {
+	if m != nil {
+		return m.SkipLatestBlockChecks
+	}
+	return false
+}
+
+// InitRequest is read from the genesis file, e.g. `"init": {"misbehavior": {"withholdBlocks": true}}`
+// starts the operator with block withholding turned on. The oracle is only there so the operator
+// accepts the same init as the PlasmaCash contract (PlasmaCashInitRequest), and is ignored.
+type InitRequest struct {
+	Oracle               *types.Address `protobuf:"bytes,1,opt,name=oracle" json:"oracle,omitempty"`
+	Misbehavior          *Misbehavior   `protobuf:"bytes,2,opt,name=misbehavior" json:"misbehavior,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *InitRequest) Reset()         { *m = InitRequest{} }
+func (m *InitRequest) String() string { return proto.CompactTextString(m) }
+func (*InitRequest) ProtoMessage()    {}
+func (*InitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_hostile_operator_8ed0aea0f91d6880, []int{1}
+}
+func (m *InitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitRequest.Unmarshal(m, b)
+}
+func (m *InitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitRequest.Marshal(b, m, deterministic)
+}
+func (dst *InitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitRequest.Merge(dst, src)
+}
+func (m *InitRequest) XXX_Size() int {
+	return xxx_messageInfo_InitRequest.Size(m)
+}
+func (m *InitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitRequest proto.InternalMessageInfo
+
+func (m *InitRequest) GetOracle() *types.Address {
+	if m != nil {
+		return m.Oracle
+	}
+	return nil
+}
+
+func (m *InitRequest) GetMisbehavior() *Misbehavior {
+	if m != nil {
+		return m.Misbehavior
+	}
+	return nil
+}
+
+type SetMisbehaviorRequest struct {
+	Misbehavior          *Misbehavior `protobuf:"bytes,1,opt,name=misbehavior" json:"misbehavior,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SetMisbehaviorRequest) Reset()         { *m = SetMisbehaviorRequest{} }
+func (m *SetMisbehaviorRequest) String() string { return proto.CompactTextString(m) }
+func (*SetMisbehaviorRequest) ProtoMessage()    {}
+func (*SetMisbehaviorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_hostile_operator_8ed0aea0f91d6880, []int{2}
+}
+func (m *SetMisbehaviorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetMisbehaviorRequest.Unmarshal(m, b)
+}
+func (m *SetMisbehaviorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetMisbehaviorRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetMisbehaviorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMisbehaviorRequest.Merge(dst, src)
+}
+func (m *SetMisbehaviorRequest) XXX_Size() int {
+	return xxx_messageInfo_SetMisbehaviorRequest.Size(m)
+}
+func (m *SetMisbehaviorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMisbehaviorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetMisbehaviorRequest proto.InternalMessageInfo
+
+func (m *SetMisbehaviorRequest) GetMisbehavior() *Misbehavior {
+	if m != nil {
+		return m.Misbehavior
+	}
+	return nil
+}
+
+type GetMisbehaviorRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMisbehaviorRequest) Reset()         { *m = GetMisbehaviorRequest{} }
+func (m *GetMisbehaviorRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMisbehaviorRequest) ProtoMessage()    {}
+func (*GetMisbehaviorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_hostile_operator_8ed0aea0f91d6880, []int{3}
+}
+func (m *GetMisbehaviorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMisbehaviorRequest.Unmarshal(m, b)
+}
+func (m *GetMisbehaviorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMisbehaviorRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetMisbehaviorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMisbehaviorRequest.Merge(dst, src)
+}
+func (m *GetMisbehaviorRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMisbehaviorRequest.Size(m)
+}
+func (m *GetMisbehaviorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMisbehaviorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMisbehaviorRequest proto.InternalMessageInfo
+
+type GetMisbehaviorResponse struct {
+	Misbehavior          *Misbehavior `protobuf:"bytes,1,opt,name=misbehavior" json:"misbehavior,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetMisbehaviorResponse) Reset()         { *m = GetMisbehaviorResponse{} }
+func (m *GetMisbehaviorResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMisbehaviorResponse) ProtoMessage()    {}
+func (*GetMisbehaviorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_hostile_operator_8ed0aea0f91d6880, []int{4}
+}
+func (m *GetMisbehaviorResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMisbehaviorResponse.Unmarshal(m, b)
+}
+func (m *GetMisbehaviorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMisbehaviorResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetMisbehaviorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMisbehaviorResponse.Merge(dst, src)
+}
+func (m *GetMisbehaviorResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMisbehaviorResponse.Size(m)
+}
+func (m *GetMisbehaviorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMisbehaviorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMisbehaviorResponse proto.InternalMessageInfo
+
+func (m *GetMisbehaviorResponse) GetMisbehavior() *Misbehavior {
+	if m != nil {
+		return m.Misbehavior
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Misbehavior)(nil), "hostile_operator.Misbehavior")
+	proto.RegisterType((*InitRequest)(nil), "hostile_operator.InitRequest")
+	proto.RegisterType((*SetMisbehaviorRequest)(nil), "hostile_operator.SetMisbehaviorRequest")
+	proto.RegisterType((*GetMisbehaviorRequest)(nil), "hostile_operator.GetMisbehaviorRequest")
+	proto.RegisterType((*GetMisbehaviorResponse)(nil), "hostile_operator.GetMisbehaviorResponse")
+}
+
+func init() {
+	proto.RegisterFile("hostile_operator/hostile_operator.proto", fileDescriptor_hostile_operator_8ed0aea0f91d6880)
+}
+
+var fileDescriptor_hostile_operator_8ed0aea0f91d6880 = []byte{
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4f, 0x6f, 0xd3, 0x40,
+	0x10, 0xc5, 0x15, 0xd2, 0x96, 0x30, 0x46, 0x4d, 0x58, 0x94, 0x62, 0x21, 0x01, 0x21, 0x97, 0x06,
+	0x04, 0x09, 0xa4, 0x07, 0x8e, 0x08, 0x2a, 0x84, 0x90, 0x40, 0x20, 0xe7, 0x00, 0x9c, 0x56, 0x8e,
+	0x33, 0xb1, 0x57, 0x71, 0x76, 0xcc, 0xce, 0xa6, 0x81, 0xef, 0xcd, 0x07, 0x40, 0x1e, 0xe7, 0x1f,
+	0x86, 0x0b, 0xe2, 0x62, 0xc9, 0xbf, 0xf7, 0xf6, 0xcd, 0xac, 0x67, 0x0c, 0xe7, 0x19, 0xb1, 0x37,
+	0x39, 0x6a, 0x2a, 0xd0, 0xc5, 0x9e, 0xdc, 0xa8, 0x0e, 0x86, 0x85, 0x23, 0x4f, 0xaa, 0x53, 0xe7,
+	0x77, 0x9f, 0xa5, 0xc6, 0x67, 0xab, 0xe9, 0x30, 0xa1, 0xe5, 0x28, 0x27, 0x5a, 0x5a, 0xf4, 0x6b,
+	0x72, 0x8b, 0x51, 0x4a, 0x4f, 0xcb, 0xd7, 0x91, 0xff, 0x51, 0x20, 0x57, 0xcf, 0x2a, 0xa3, 0xff,
+	0xb3, 0x09, 0xc1, 0x07, 0xc3, 0x53, 0xcc, 0xe2, 0x2b, 0x43, 0x4e, 0x9d, 0x43, 0x7b, 0x6d, 0x7c,
+	0x96, 0x51, 0x3e, 0xd3, 0xd3, 0x9c, 0x92, 0x05, 0x87, 0x8d, 0x5e, 0x63, 0xd0, 0x8a, 0x4e, 0xb7,
+	0xf8, 0xb5, 0x50, 0xf5, 0x04, 0x14, 0xa3, 0xbb, 0x42, 0xbd, 0x76, 0x64, 0x53, 0x5d, 0x38, 0xa2,
+	0x39, 0x87, 0xd7, 0xc4, 0xdb, 0x11, 0xe5, 0x73, 0x29, 0x7c, 0x12, 0xae, 0x1e, 0x41, 0x67, 0x4e,
+	0x2e, 0x45, 0xcd, 0x26, 0xb5, 0xb1, 0x5f, 0x39, 0xe4, 0xb0, 0x29, 0xde, 0xb6, 0xf0, 0xc9, 0x0e,
+	0xab, 0xe7, 0xd0, 0x4e, 0xd0, 0x32, 0x39, 0x9c, 0x69, 0x5a, 0x5b, 0x74, 0x1c, 0x1e, 0xf5, 0x9a,
+	0x83, 0x60, 0xdc, 0x1a, 0xbe, 0x9a, 0xcd, 0x1c, 0x32, 0x47, 0xa7, 0x5b, 0xc3, 0x47, 0xd1, 0xd5,
+	0x03, 0x08, 0xd8, 0x63, 0x9c, 0x6b, 0xce, 0xc9, 0x73, 0x78, 0xdc, 0x6b, 0x0e, 0x8e, 0x22, 0x10,
+	0x34, 0x29, 0x89, 0xba, 0x0f, 0xc7, 0x3e, 0x33, 0x38, 0x0f, 0x4f, 0x7a, 0x8d, 0xdf, 0x92, 0x2a,
+	0xac, 0xc6, 0xd0, 0xe5, 0x85, 0x29, 0xf6, 0xdd, 0xe9, 0x24, 0xc3, 0xf2, 0xee, 0xd7, 0xa5, 0xc7,
+	0xdb, 0xa5, 0xb8, 0x6b, 0xf1, 0x52, 0x24, 0xf5, 0x18, 0x6e, 0xc9, 0x19, 0xe9, 0x71, 0xeb, 0x6f,
+	0x55, 0x77, 0x2a, 0x05, 0xe9, 0x6d, 0xe3, 0xbd, 0x80, 0x33, 0xf1, 0x26, 0x64, 0xac, 0x66, 0x1f,
+	0xfb, 0x5d, 0x81, 0x1b, 0xfb, 0x02, 0x97, 0x64, 0xec, 0xa4, 0xd4, 0x36, 0x87, 0x1e, 0xc2, 0x4d,
+	0x93, 0x5a, 0x72, 0xa8, 0xf1, 0xbb, 0xf1, 0x1c, 0x82, 0x58, 0x83, 0x8a, 0xbd, 0x29, 0x91, 0x7a,
+	0x01, 0xa1, 0xe4, 0xe6, 0xb1, 0x47, 0xf6, 0xd5, 0xc0, 0xb6, 0xc9, 0x81, 0xd8, 0xe5, 0x5e, 0xef,
+	0x45, 0x96, 0xc1, 0x55, 0xd9, 0xfd, 0x02, 0x82, 0x77, 0xd6, 0xf8, 0x08, 0xbf, 0xad, 0x90, 0xbd,
+	0xea, 0xc1, 0x09, 0xb9, 0x38, 0xc9, 0x31, 0x6c, 0xd4, 0x3e, 0xd0, 0x86, 0xab, 0x97, 0x10, 0x2c,
+	0xf7, 0x6b, 0x22, 0x73, 0x0e, 0xc6, 0xf7, 0x86, 0x7f, 0x6c, 0xe6, 0xc1, 0x2e, 0x45, 0x87, 0x27,
+	0xfa, 0x5f, 0xa0, 0x3b, 0x41, 0x7f, 0x28, 0x6f, 0x6a, 0xd7, 0x92, 0x1b, 0xff, 0x9c, 0x7c, 0x07,
+	0xba, 0x6f, 0xff, 0x96, 0xdc, 0xff, 0x0a, 0x67, 0x75, 0x81, 0x0b, 0xb2, 0x8c, 0xff, 0x5d, 0x73,
+	0x7a, 0x22, 0x7f, 0xcf, 0xc5, 0xaf, 0x01, 0x00, 0xa6, 0x25, 0x42, 0x29, 0xac, 0x03, 0x00, 0x00,
+}
@@ -0,0 +1,294 @@
+package hostile_operator
+
+import (
+	"crypto/ecdsa"
+	"smt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	loom "github.com/loomnetwork/go-loom"
+	pctypes "github.com/loomnetwork/go-loom/builtin/types/plasma_cash"
+	"github.com/loomnetwork/go-loom/plugin"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/pkg/errors"
+	. "gopkg.in/check.v1"
+
+	"txvalidation"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type HostileOperatorTestSuite struct {
+	ctx      contract.Context
+	operator *HostileOperator
+	// Ethereum block number of the last request passed to ProcessRequestBatch
+	ethBlock uint64
+}
+
+var _ = Suite(&HostileOperatorTestSuite{})
+
+var (
+	aliceKey, _ = crypto.HexToECDSA("c87509a1c067bbde78beb793e6fa76530b6382a4c0241e5e4a9ec0a0f44dc0d3")
+	alice       = loom.Address{ChainID: "eth", Local: crypto.PubkeyToAddress(aliceKey.PublicKey).Bytes()}
+	bob         = loom.MustParseAddress("eth:0x7f6d3b8a1a3d8c7e0e2a92b0b0e83b2bbc0fd4f2")
+)
+
+func (s *HostileOperatorTestSuite) SetUpTest(c *C) {
+	contractAddr := loom.MustParseAddress("default:0x9a1aC42a17AAD6Dbc6d21c162989d0f701074044")
+	s.ctx = contract.WrapPluginContext(plugin.CreateFakeContext(contractAddr, contractAddr))
+	s.operator = &HostileOperator{}
+	s.ethBlock = 0
+	c.Assert(s.operator.Init(s.ctx, &InitRequest{}), IsNil)
+
+	s.process(c, &pctypes.PlasmaCashRequest{Data: &pctypes.PlasmaCashRequest_Deposit{Deposit: &DepositRequest{
+		Slot:         5,
+		DepositBlock: &types.BigUInt{Value: *loom.NewBigUIntFromInt(1)},
+		Denomination: &types.BigUInt{Value: *loom.NewBigUIntFromInt(1)},
+		From:         alice.MarshalPB(),
+	}}})
+}
+
+// process passes a batch containing a single request to ProcessRequestBatch.
+func (s *HostileOperatorTestSuite) process(c *C, req *pctypes.PlasmaCashRequest) {
+	s.ethBlock++
+	req.Meta = &pctypes.PlasmaCashEventMeta{BlockNumber: s.ethBlock}
+	batch := &pctypes.PlasmaCashRequestBatch{Requests: []*pctypes.PlasmaCashRequest{req}}
+	c.Assert(s.operator.ProcessRequestBatch(s.ctx, batch), IsNil)
+
+	tally, err := s.operator.GetRequestBatchTally(s.ctx, &GetRequestBatchTallyRequest{})
+	c.Assert(err, IsNil)
+	c.Assert(tally.LastSeenBlockNumber, Equals, s.ethBlock)
+}
+
+func (s *HostileOperatorTestSuite) startExit(c *C, slot uint64) {
+	s.process(c, &pctypes.PlasmaCashRequest{Data: &pctypes.PlasmaCashRequest_StartedExit{StartedExit: &ExitCoinRequest{Slot: slot, Owner: alice.MarshalPB()}}})
+}
+
+func (s *HostileOperatorTestSuite) resetCoin(c *C, slot uint64) {
+	s.process(c, &pctypes.PlasmaCashRequest{Data: &pctypes.PlasmaCashRequest_CoinReset{CoinReset: &CoinResetRequest{Slot: slot, Owner: alice.MarshalPB()}}})
+}
+
+func (s *HostileOperatorTestSuite) withdraw(c *C, slot uint64, owner loom.Address) {
+	s.process(c, &pctypes.PlasmaCashRequest{Data: &pctypes.PlasmaCashRequest_Withdraw{Withdraw: &WithdrawCoinRequest{Slot: slot, Owner: owner.MarshalPB()}}})
+}
+
+func (s *HostileOperatorTestSuite) setMisbehavior(c *C, misbehavior *Misbehavior) {
+	c.Assert(s.operator.SetMisbehavior(s.ctx, &SetMisbehaviorRequest{Misbehavior: misbehavior}), IsNil)
+}
+
+// transferFromAlice returns a tx that transfers the coin deposited by Alice to the given owner,
+// signed by Alice.
+func transferFromAlice(c *C, to loom.Address) *PlasmaTx {
+	tx := &PlasmaTx{
+		Slot:          5,
+		PreviousBlock: &types.BigUInt{Value: *loom.NewBigUIntFromInt(1)},
+		Denomination:  &types.BigUInt{Value: *loom.NewBigUIntFromInt(1)},
+		NewOwner:      to.MarshalPB(),
+	}
+	signTx(c, tx, aliceKey)
+	return tx
+}
+
+func signTx(c *C, tx *PlasmaTx, key *ecdsa.PrivateKey) {
+	hash, err := txvalidation.Hash(tx)
+	c.Assert(err, IsNil)
+	sig, err := crypto.Sign(hash, key)
+	c.Assert(err, IsNil)
+	sig[64] += 27
+	// EIP712 sig type
+	tx.Signature = append([]byte{0}, sig...)
+}
+
+// submitBlock submits the pending txs in a new block, and returns the height of the block.
+func (s *HostileOperatorTestSuite) submitBlock(c *C) *types.BigUInt {
+	_, err := s.operator.SubmitBlockToMainnet(s.ctx, &SubmitBlockToMainnetRequest{})
+	c.Assert(err, IsNil)
+	res, err := s.operator.GetCurrentBlockRequest(s.ctx, &GetCurrentBlockRequest{})
+	c.Assert(err, IsNil)
+	return res.BlockHeight
+}
+
+// checkProof returns true if the proof served for the given slot verifies against the block root.
+func (s *HostileOperatorTestSuite) checkProof(c *C, height *types.BigUInt, slot uint64) bool {
+	block, err := s.operator.GetBlockRequest(s.ctx, &GetBlockRequest{BlockHeight: height})
+	c.Assert(err, IsNil)
+	res, err := s.operator.GetPlasmaTxRequest(s.ctx, &GetPlasmaTxRequest{BlockHeight: height, Slot: slot})
+	c.Assert(err, IsNil)
+	leaf := smt.EmptyLeaf
+	if len(res.Plasmatx.MerkleHash) > 0 {
+		leaf = common.BytesToHash(res.Plasmatx.MerkleHash)
+	}
+	valid, err := smt.CheckMembership(leaf, common.BytesToHash(block.Block.MerkleHash), slot, res.Plasmatx.Proof)
+	c.Assert(err, IsNil)
+	return valid
+}
+
+func (s *HostileOperatorTestSuite) coinState(c *C, slot uint64) CoinState {
+	coin, err := loadCoin(s.ctx, slot)
+	c.Assert(err, IsNil)
+	return coin.State
+}
+
+func (s *HostileOperatorTestSuite) userSlots(c *C, owner loom.Address) []uint64 {
+	res, err := s.operator.GetUserSlotsRequest(s.ctx, &GetUserSlotsRequest{From: owner.MarshalPB()})
+	c.Assert(err, IsNil)
+	return res.Slots
+}
+
+func (s *HostileOperatorTestSuite) TestExitResetAndWithdraw(c *C) {
+	c.Assert(s.coinState(c, 5), Equals, CoinState_DEPOSITED)
+	c.Assert(s.userSlots(c, alice), DeepEquals, []uint64{5})
+
+	s.startExit(c, 5)
+	c.Assert(s.coinState(c, 5), Equals, CoinState_EXITING)
+
+	s.resetCoin(c, 5)
+	c.Assert(s.coinState(c, 5), Equals, CoinState_DEPOSITED)
+
+	// Bad transitions are skipped without failing the batch
+	s.resetCoin(c, 5)
+	c.Assert(s.coinState(c, 5), Equals, CoinState_DEPOSITED)
+	s.startExit(c, 5)
+	s.startExit(c, 5)
+	c.Assert(s.coinState(c, 5), Equals, CoinState_EXITING)
+	s.startExit(c, 6)
+
+	// The coin ended up with Bob, who withdraws it
+	s.withdraw(c, 5, bob)
+	c.Assert(s.ctx.Has(coinKey(5)), Equals, false)
+	c.Assert(s.userSlots(c, alice), HasLen, 0)
+	c.Assert(s.ctx.Has(accountKey(bob)), Equals, false)
+}
+
+func (s *HostileOperatorTestSuite) TestIgnoreExits(c *C) {
+	err := s.operator.SetMisbehavior(s.ctx, &SetMisbehaviorRequest{Misbehavior: &Misbehavior{IgnoreExits: true}})
+	c.Assert(err, IsNil)
+
+	s.startExit(c, 5)
+	c.Assert(s.coinState(c, 5), Equals, CoinState_DEPOSITED)
+
+	s.withdraw(c, 5, alice)
+	c.Assert(s.coinState(c, 5), Equals, CoinState_DEPOSITED)
+	c.Assert(s.userSlots(c, alice), DeepEquals, []uint64{5})
+}
+
+func (s *HostileOperatorTestSuite) TestServeWrongProofs(c *C) {
+	c.Assert(s.operator.PlasmaTxRequest(s.ctx, &PlasmaTxRequest{Plasmatx: transferFromAlice(c, bob)}), IsNil)
+	height := s.submitBlock(c)
+	c.Assert(s.checkProof(c, height, 5), Equals, true)
+	c.Assert(s.checkProof(c, height, 6), Equals, true)
+
+	s.setMisbehavior(c, &Misbehavior{ServeWrongProofs: true})
+	c.Assert(s.checkProof(c, height, 5), Equals, false)
+	// Neither slot 6 nor its neighbour are in the block, so the proof of the neighbouring slot would
+	// still verify
+	c.Assert(s.checkProof(c, height, 6), Equals, false)
+
+	// Proofs of empty trees don't include any siblings
+	valid, err := smt.CheckMembership(smt.EmptyLeaf, smt.DefaultHashes[smt.Depth], 6, corruptProof(make([]byte, 8)))
+	c.Assert(err, IsNil)
+	c.Assert(valid, Equals, false)
+}
+
+func (s *HostileOperatorTestSuite) TestCensoredOwners(c *C) {
+	s.setMisbehavior(c, &Misbehavior{CensoredOwners: []*types.Address{alice.MarshalPB()}})
+	err := s.operator.PlasmaTxRequest(s.ctx, &PlasmaTxRequest{Plasmatx: transferFromAlice(c, bob)})
+	c.Assert(err, ErrorMatches, "Error appending plasma transaction for slot 5")
+
+	s.setMisbehavior(c, &Misbehavior{CensoredOwners: []*types.Address{bob.MarshalPB()}})
+	c.Assert(s.operator.PlasmaTxRequest(s.ctx, &PlasmaTxRequest{Plasmatx: transferFromAlice(c, bob)}), IsNil)
+}
+
+func (s *HostileOperatorTestSuite) TestForgeSignatures(c *C) {
+	s.setMisbehavior(c, &Misbehavior{ForgeSignatures: true})
+	tx := transferFromAlice(c, bob)
+	sig := append([]byte{}, tx.Signature...)
+	c.Assert(s.operator.PlasmaTxRequest(s.ctx, &PlasmaTxRequest{Plasmatx: tx}), IsNil)
+	height := s.submitBlock(c)
+
+	res, err := s.operator.GetPlasmaTxRequest(s.ctx, &GetPlasmaTxRequest{BlockHeight: height, Slot: 5})
+	c.Assert(err, IsNil)
+	c.Assert(res.Plasmatx.Signature, HasLen, len(sig))
+	c.Assert(res.Plasmatx.Signature, Not(DeepEquals), sig)
+	// The forged sig is well-formed, but it isn't Alice's
+	signer, err := txvalidation.RecoverSigner(res.Plasmatx.MerkleHash, res.Plasmatx.Signature)
+	if err == nil {
+		c.Assert(signer.Bytes(), Not(DeepEquals), []byte(alice.Local))
+	}
+}
+
+func (s *HostileOperatorTestSuite) TestWithholdBlocks(c *C) {
+	s.setMisbehavior(c, &Misbehavior{WithholdBlocks: true})
+	c.Assert(s.operator.PlasmaTxRequest(s.ctx, &PlasmaTxRequest{Plasmatx: transferFromAlice(c, bob)}), IsNil)
+	height := s.submitBlock(c)
+
+	// Withheld blocks stay unavailable after withholding is turned off
+	s.setMisbehavior(c, &Misbehavior{})
+	_, err := s.operator.GetBlockRequest(s.ctx, &GetBlockRequest{BlockHeight: height})
+	c.Assert(err, ErrorMatches, "block .* is unavailable")
+	_, err = s.operator.GetPlasmaTxRequest(s.ctx, &GetPlasmaTxRequest{BlockHeight: height, Slot: 5})
+	c.Assert(err, ErrorMatches, "block .* is unavailable")
+
+	// Deposit blocks are still served
+	res, err := s.operator.GetBlockRequest(s.ctx, &GetBlockRequest{BlockHeight: &types.BigUInt{Value: *loom.NewBigUIntFromInt(1)}})
+	c.Assert(err, IsNil)
+	c.Assert(res.Block.Transactions, HasLen, 1)
+}
+
+func (s *HostileOperatorTestSuite) stealSlots(c *C) []uint64 {
+	res, err := s.operator.GetMisbehavior(s.ctx, &GetMisbehaviorRequest{})
+	c.Assert(err, IsNil)
+	return res.Misbehavior.StealSlots
+}
+
+func (s *HostileOperatorTestSuite) TestStealCoins(c *C) {
+	s.setMisbehavior(c, &Misbehavior{StealSlots: []uint64{5, 6}, Thief: bob.MarshalPB()})
+	c.Assert(s.operator.PlasmaTxRequest(s.ctx, &PlasmaTxRequest{Plasmatx: transferFromAlice(c, alice)}), IsNil)
+
+	// Coin 5 already has a pending tx so it's stolen in the next block, the unknown coin 6 is
+	// dropped without failing the block
+	height := s.submitBlock(c)
+	c.Assert(s.stealSlots(c), DeepEquals, []uint64{5})
+	res, err := s.operator.GetBlockRequest(s.ctx, &GetBlockRequest{BlockHeight: height})
+	c.Assert(err, IsNil)
+	c.Assert(res.Block.Transactions, HasLen, 1)
+	c.Assert(res.Block.Transactions[0].NewOwner.Local, DeepEquals, []byte(alice.Local))
+
+	stolenHeight := s.submitBlock(c)
+	c.Assert(s.stealSlots(c), HasLen, 0)
+	res, err = s.operator.GetBlockRequest(s.ctx, &GetBlockRequest{BlockHeight: stolenHeight})
+	c.Assert(err, IsNil)
+	c.Assert(res.Block.Transactions, HasLen, 1)
+	stolen := res.Block.Transactions[0]
+	c.Assert(stolen.Slot, Equals, uint64(5))
+	c.Assert(stolen.PreviousBlock.Value.Cmp(&height.Value), Equals, 0)
+	c.Assert(stolen.NewOwner.Local, DeepEquals, []byte(bob.Local))
+}
+
+func (s *HostileOperatorTestSuite) TestPlasmaTxValidation(c *C) {
+	// Only Alice can transfer her coin
+	malloryKey, err := crypto.GenerateKey()
+	c.Assert(err, IsNil)
+	forged := transferFromAlice(c, bob)
+	signTx(c, forged, malloryKey)
+	err = s.operator.PlasmaTxRequest(s.ctx, &PlasmaTxRequest{Plasmatx: forged})
+	c.Assert(errors.Cause(err), Equals, txvalidation.ErrNotOwner)
+
+	c.Assert(s.operator.PlasmaTxRequest(s.ctx, &PlasmaTxRequest{Plasmatx: transferFromAlice(c, bob)}), IsNil)
+	s.submitBlock(c)
+
+	// Alice can't spend the deposit again now that the coin belongs to Bob
+	err = s.operator.PlasmaTxRequest(s.ctx, &PlasmaTxRequest{Plasmatx: transferFromAlice(c, alice)})
+	c.Assert(errors.Cause(err), Equals, txvalidation.ErrStalePrevBlock)
+	s.setMisbehavior(c, &Misbehavior{SkipLatestBlockChecks: true})
+	c.Assert(s.operator.PlasmaTxRequest(s.ctx, &PlasmaTxRequest{Plasmatx: transferFromAlice(c, alice)}), IsNil)
+	s.submitBlock(c)
+
+	// Exiting coins can't be transferred
+	s.setMisbehavior(c, &Misbehavior{})
+	s.startExit(c, 5)
+	err = s.operator.PlasmaTxRequest(s.ctx, &PlasmaTxRequest{Plasmatx: transferFromAlice(c, bob)})
+	c.Assert(errors.Cause(err), Equals, txvalidation.ErrCoinNotDeposited)
+}
@@ -0,0 +1,38 @@
+package hostile_operator
+
+import (
+	"github.com/loomnetwork/go-loom/types"
+
+	"txvalidation"
+)
+
+// TxChecks returns the txvalidation checks the operator performs on submitted txs.
+func (m *Misbehavior) TxChecks() txvalidation.Check {
+	checks := txvalidation.AllChecks
+	if m.SkipSignatureChecks {
+		checks &^= txvalidation.CheckSignature
+	}
+	if m.SkipOwnerChecks {
+		checks &^= txvalidation.CheckOwner
+	}
+	if m.SkipCoinStateChecks {
+		checks &^= txvalidation.CheckCoinState
+	}
+	if m.SkipLatestBlockChecks {
+		checks &^= txvalidation.CheckLatestBlock
+	}
+	return checks
+}
+
+// IsCensored returns true if txs transferring coins owned by the given account should be rejected.
+func (m *Misbehavior) IsCensored(owner *types.Address) bool {
+	if owner == nil {
+		return false
+	}
+	for _, addr := range m.CensoredOwners {
+		if addr.ChainId == owner.ChainId && string(addr.Local) == string(owner.Local) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+// Package smt verifies proofs of the 64 level deep sparse merkle trees the Plasma Cash operator
+// builds for each Plasma block, the verification is equivalent to SparseMerkleTree.sol.
+//
+// Proofs are in the compressed format produced by mamamerkle.CreateMerkleProof: the first 8 bytes
+// are a big-endian bitmap where bit N (counting from the least significant bit) is set if the
+// sibling at level N is included in the proof, followed by the 32 byte hashes of all the included
+// siblings in order of increasing level. Siblings that aren't included have the default hash of the
+// corresponding level.
+package smt
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Depth of the sparse merkle tree, the slot of a coin is the index of its leaf.
+const Depth = 64
+
+// MaxProofLength is the length of a proof that includes the siblings at every level of the tree.
+const MaxProofLength = 8 + Depth*32
+
+// DefaultHashes contains the hash of an empty sub-tree at each level of the tree, the default
+// hash of level 0 is the value of an empty leaf, keccak256(uint256(0)).
+var DefaultHashes [Depth + 1]common.Hash
+
+// EmptyLeaf is the value of a leaf that doesn't contain a tx.
+var EmptyLeaf common.Hash
+
+func init() {
+	DefaultHashes[0] = crypto.Keccak256Hash(make([]byte, 32))
+	for i := 1; i <= Depth; i++ {
+		DefaultHashes[i] = crypto.Keccak256Hash(DefaultHashes[i-1][:], DefaultHashes[i-1][:])
+	}
+	EmptyLeaf = DefaultHashes[0]
+}
+
+// ComputeRoot returns the root of the tree that contains the given leaf at the given slot,
+// as implied by the proof. An error is returned if the proof is malformed.
+func ComputeRoot(leaf common.Hash, slot uint64, proof []byte) (common.Hash, error) {
+	if len(proof) < 8 || (len(proof)-8)%32 != 0 || len(proof) > MaxProofLength {
+		return common.Hash{}, fmt.Errorf("invalid proof length %d", len(proof))
+	}
+	proofBits := binary.BigEndian.Uint64(proof[:8])
+	p := 8
+	computedHash := leaf
+	index := slot
+	for d := 0; d < Depth; d++ {
+		var proofElement common.Hash
+		if proofBits%2 == 0 {
+			proofElement = DefaultHashes[d]
+		} else {
+			if len(proof) < p+32 {
+				return common.Hash{}, fmt.Errorf("proof is missing sibling at level %d", d)
+			}
+			proofElement = common.BytesToHash(proof[p : p+32])
+			p += 32
+		}
+		if index%2 == 0 {
+			computedHash = crypto.Keccak256Hash(computedHash[:], proofElement[:])
+		} else {
+			computedHash = crypto.Keccak256Hash(proofElement[:], computedHash[:])
+		}
+		proofBits /= 2
+		index /= 2
+	}
+	return computedHash, nil
+}
+
+// CheckMembership returns true if the proof shows that the given leaf is at the given slot of
+// the tree with the given root. An error is returned if the proof is malformed.
+func CheckMembership(leaf, root common.Hash, slot uint64, proof []byte) (bool, error) {
+	computedHash, err := ComputeRoot(leaf, slot, proof)
+	if err != nil {
+		return false, err
+	}
+	return computedHash == root, nil
+}
+
+// CheckExclusion returns true if the proof shows that the leaf at the given slot of the tree with
+// the given root is empty. An error is returned if the proof is malformed.
+func CheckExclusion(root common.Hash, slot uint64, proof []byte) (bool, error) {
+	return CheckMembership(EmptyLeaf, root, slot, proof)
+}
@@ -0,0 +1,114 @@
+package smt
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type SMTTestSuite struct{}
+
+var _ = Suite(&SMTTestSuite{})
+
+// Test vectors generated by server/scripts/gen_smt_vectors.js from the JS SparseMerkleTree helper.
+type testTree struct {
+	Leaves map[string]string `json:"leaves"`
+	Root   string            `json:"root"`
+	Proofs []struct {
+		Slot  string `json:"slot"`
+		Leaf  string `json:"leaf"`
+		Proof string `json:"proof"`
+	} `json:"proofs"`
+}
+
+func loadTestTrees(c *C) []testTree {
+	data, err := ioutil.ReadFile("testdata/vectors.json")
+	c.Assert(err, IsNil)
+	var trees []testTree
+	c.Assert(json.Unmarshal(data, &trees), IsNil)
+	c.Assert(len(trees) > 0, Equals, true)
+	return trees
+}
+
+func (s *SMTTestSuite) TestDefaultHashes(c *C) {
+	c.Assert(
+		DefaultHashes[0].Hex(), Equals,
+		"0x290decd9548b62a8d60345a988386fc84ba6bc95484008f6362f93160ef3e563",
+	)
+	c.Assert(EmptyLeaf, Equals, DefaultHashes[0])
+}
+
+func (s *SMTTestSuite) TestProofVectors(c *C) {
+	for _, tree := range loadTestTrees(c) {
+		root := common.HexToHash(tree.Root)
+		for _, p := range tree.Proofs {
+			slot, err := strconv.ParseUint(p.Slot, 10, 64)
+			c.Assert(err, IsNil)
+			leaf := common.HexToHash(p.Leaf)
+			proof := hexutil.MustDecode(p.Proof)
+
+			ok, err := CheckMembership(leaf, root, slot, proof)
+			c.Assert(err, IsNil)
+			c.Assert(ok, Equals, true, Commentf("slot %d of tree %s", slot, tree.Root))
+
+			_, isLeaf := tree.Leaves[p.Slot]
+			excluded, err := CheckExclusion(root, slot, proof)
+			c.Assert(err, IsNil)
+			c.Assert(excluded, Equals, !isLeaf, Commentf("slot %d of tree %s", slot, tree.Root))
+
+			if isLeaf {
+				// The proof of a leaf shouldn't be valid for the neighbouring slot
+				ok, err = CheckMembership(leaf, root, slot^1, proof)
+				c.Assert(err, IsNil)
+				c.Assert(ok, Equals, false)
+			}
+		}
+	}
+}
+
+func (s *SMTTestSuite) TestTamperedProof(c *C) {
+	for _, tree := range loadTestTrees(c) {
+		root := common.HexToHash(tree.Root)
+		for _, p := range tree.Proofs {
+			slot, err := strconv.ParseUint(p.Slot, 10, 64)
+			c.Assert(err, IsNil)
+			leaf := common.HexToHash(p.Leaf)
+			proof := hexutil.MustDecode(p.Proof)
+			if len(proof) == 8 {
+				continue
+			}
+			tampered := append([]byte{}, proof...)
+			tampered[len(tampered)-1] ^= 1
+			ok, err := CheckMembership(leaf, root, slot, tampered)
+			c.Assert(err, IsNil)
+			c.Assert(ok, Equals, false)
+		}
+	}
+}
+
+func (s *SMTTestSuite) TestMalformedProof(c *C) {
+	leaf := common.HexToHash("0x01")
+	for _, proof := range [][]byte{
+		nil,
+		make([]byte, 7),
+		make([]byte, 9),
+		make([]byte, MaxProofLength+32),
+		// bitmap claims a sibling at level 0 but the proof doesn't contain any
+		{0, 0, 0, 0, 0, 0, 0, 1},
+	} {
+		_, err := CheckMembership(leaf, DefaultHashes[Depth], 2, proof)
+		c.Assert(err, NotNil, Commentf("proof of length %d", len(proof)))
+	}
+
+	// An empty tree has the default root
+	ok, err := CheckExclusion(DefaultHashes[Depth], 12345, make([]byte, 8))
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, true)
+}
@@ -0,0 +1,176 @@
+// Package txvalidation implements the checks a Plasma Cash operator should perform before adding a
+// tx to the pending txs of the next block: the tx must spend the latest block of the coin, it must
+// be signed by the owner of the coin as of that block, and the coin must not be exiting on the
+// RootChain.
+//
+// The checks can be turned off individually, which allows the HostileOperator to bypass any of
+// them.
+package txvalidation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	pctypes "github.com/loomnetwork/go-loom/builtin/types/plasma_cash"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/pkg/errors"
+)
+
+// Check identifies one of the validation checks.
+type Check uint
+
+const (
+	// The tx signature must be well-formed, and a signer must be recoverable from it.
+	CheckSignature Check = 1 << iota
+	// The tx must be signed by the owner of the coin as of the block the tx spends.
+	CheckOwner
+	// The coin must be DEPOSITED, i.e. not exiting or exited.
+	CheckCoinState
+	// The tx must spend the latest block that includes a tx of the coin, so the owner can't spend
+	// the coin again by referencing an older block.
+	CheckLatestBlock
+
+	AllChecks = CheckSignature | CheckOwner | CheckCoinState | CheckLatestBlock
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid tx signature")
+	ErrNotOwner         = errors.New("tx isn't signed by the owner of the coin")
+	ErrInvalidPrevBlock = errors.New("previous block doesn't contain a tx for the coin")
+	ErrCoinNotDeposited = errors.New("coin isn't deposited")
+	ErrStalePrevBlock   = errors.New("previous block isn't the latest block of the coin")
+)
+
+// Signature modes, as declared in ECVerify.sol.
+const (
+	sigModeEIP712 byte = iota
+	sigModeGeth
+	sigModeTrezor
+)
+
+// Length of a tx signature, a sig mode byte followed by a 65 byte [R || S || V] ECDSA sig.
+const SignatureLength = 66
+
+// State provides the operator state needed to validate txs.
+type State interface {
+	// Block returns the Plasma block at the given height.
+	Block(height *types.BigUInt) (*pctypes.PlasmaBlock, error)
+	// Coin returns the operator's record of the coin in the given slot.
+	Coin(slot uint64) (*pctypes.PlasmaCashCoin, error)
+	// LatestBlock returns the height of the latest Plasma block that includes a tx of the coin in
+	// the given slot.
+	LatestBlock(slot uint64) (*types.BigUInt, error)
+}
+
+// Validate performs the given checks on a tx that's about to be added to the pending txs, and
+// returns the first check that fails.
+func Validate(state State, tx *pctypes.PlasmaTx, checks Check) error {
+	if checks&CheckCoinState != 0 {
+		coin, err := state.Coin(tx.Slot)
+		if err != nil {
+			return errors.Wrapf(err, "failed to load coin %d", tx.Slot)
+		}
+		if coin.State != pctypes.PlasmaCashCoinState_DEPOSITED {
+			return errors.Wrapf(ErrCoinNotDeposited, "coin %d is %v", tx.Slot, coin.State)
+		}
+	}
+
+	if checks&CheckLatestBlock != 0 {
+		latest, err := state.LatestBlock(tx.Slot)
+		if err != nil {
+			return errors.Wrapf(err, "failed to load latest block of coin %d", tx.Slot)
+		}
+		if tx.PreviousBlock == nil || tx.PreviousBlock.Value.Cmp(&latest.Value) != 0 {
+			return errors.Wrapf(ErrStalePrevBlock, "latest block of coin %d is %v", tx.Slot, latest.Value.String())
+		}
+	}
+
+	if checks&(CheckSignature|CheckOwner) == 0 {
+		return nil
+	}
+	hash, err := Hash(tx)
+	if err != nil {
+		return err
+	}
+	signer, err := RecoverSigner(hash, tx.Signature)
+	if err != nil {
+		return err
+	}
+
+	if checks&CheckOwner != 0 {
+		owner, err := OwnerAt(state, tx.Slot, tx.PreviousBlock)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(signer.Bytes(), owner.Local) {
+			return errors.Wrapf(ErrNotOwner, "coin %d is owned by %x, tx signed by %s", tx.Slot, owner.Local, signer.Hex())
+		}
+	}
+	return nil
+}
+
+// OwnerAt returns the owner of the coin in the given slot as of the given block, i.e. the new
+// owner in the tx of that block which transferred the coin.
+func OwnerAt(state State, slot uint64, height *types.BigUInt) (*types.Address, error) {
+	block, err := state.Block(height)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load block %v", height.Value.String())
+	}
+	for _, tx := range block.Transactions {
+		if tx.Slot == slot && tx.NewOwner != nil {
+			return tx.NewOwner, nil
+		}
+	}
+	return nil, errors.Wrapf(ErrInvalidPrevBlock, "no tx for coin %d in block %v", slot, height.Value.String())
+}
+
+// Hash returns the hash the owner of a coin signs to transfer the coin, which is also the leaf of
+// the tx in the merkle tree of the block that includes the tx.
+func Hash(tx *pctypes.PlasmaTx) ([]byte, error) {
+	if tx.PreviousBlock == nil || tx.Denomination == nil || tx.NewOwner == nil {
+		return nil, fmt.Errorf("tx for coin %d is incomplete", tx.Slot)
+	}
+	data, err := rlp.EncodeToBytes([]interface{}{
+		uint64(tx.Slot),
+		tx.PreviousBlock.Value.Bytes(),
+		uint32(tx.Denomination.Value.Int64()),
+		tx.NewOwner.Local,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Keccak256(data), nil
+}
+
+// RecoverSigner returns the address that signed the given hash, the sig is interpreted the same
+// way as in ECVerify.sol.
+func RecoverSigner(hash []byte, sig []byte) (common.Address, error) {
+	if len(sig) != SignatureLength {
+		return common.Address{}, errors.Wrapf(ErrInvalidSignature, "sig length is %d", len(sig))
+	}
+	switch sig[0] {
+	case sigModeEIP712:
+	case sigModeGeth:
+		hash = crypto.Keccak256([]byte("\x19Ethereum Signed Message:\n32"), hash)
+	case sigModeTrezor:
+		hash = crypto.Keccak256([]byte("\x19Ethereum Signed Message:\n\x20"), hash)
+	default:
+		return common.Address{}, errors.Wrapf(ErrInvalidSignature, "unknown sig mode %d", sig[0])
+	}
+
+	// ecrecover only accepts a V of 27 or 28, while go-ethereum expects V to be 0 or 1
+	ecSig := make([]byte, 65)
+	copy(ecSig, sig[1:])
+	if ecSig[64] != 27 && ecSig[64] != 28 {
+		return common.Address{}, errors.Wrapf(ErrInvalidSignature, "sig V is %d", ecSig[64])
+	}
+	ecSig[64] -= 27
+	pubKey, err := crypto.SigToPub(hash, ecSig)
+	if err != nil {
+		return common.Address{}, errors.Wrap(ErrInvalidSignature, err.Error())
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
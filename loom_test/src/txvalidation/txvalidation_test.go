@@ -0,0 +1,168 @@
+package txvalidation
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	loom "github.com/loomnetwork/go-loom"
+	pctypes "github.com/loomnetwork/go-loom/builtin/types/plasma_cash"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/pkg/errors"
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type TxValidationTestSuite struct{}
+
+var _ = Suite(&TxValidationTestSuite{})
+
+type testState struct {
+	blocks       map[int64]*pctypes.PlasmaBlock
+	coins        map[uint64]*pctypes.PlasmaCashCoin
+	latestBlocks map[uint64]int64
+}
+
+func (s *testState) Block(height *types.BigUInt) (*pctypes.PlasmaBlock, error) {
+	block, ok := s.blocks[height.Value.Int64()]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return block, nil
+}
+
+func (s *testState) Coin(slot uint64) (*pctypes.PlasmaCashCoin, error) {
+	coin, ok := s.coins[slot]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return coin, nil
+}
+
+func (s *testState) LatestBlock(slot uint64) (*types.BigUInt, error) {
+	height, ok := s.latestBlocks[slot]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return bigUInt(height), nil
+}
+
+func bigUInt(n int64) *types.BigUInt {
+	return &types.BigUInt{Value: *loom.NewBigUIntFromInt(n)}
+}
+
+func ethAddress(key *ecdsa.PrivateKey) *types.Address {
+	return &types.Address{ChainId: "eth", Local: crypto.PubkeyToAddress(key.PublicKey).Bytes()}
+}
+
+func signTx(c *C, tx *pctypes.PlasmaTx, key *ecdsa.PrivateKey) {
+	hash, err := Hash(tx)
+	c.Assert(err, IsNil)
+	sig, err := crypto.Sign(hash, key)
+	c.Assert(err, IsNil)
+	sig[64] += 27
+	tx.Signature = append([]byte{sigModeEIP712}, sig...)
+}
+
+// newTestState returns a state in which the coin in slot 5 was deposited by the owner in block 3.
+func newTestState(owner *ecdsa.PrivateKey) *testState {
+	return &testState{
+		blocks: map[int64]*pctypes.PlasmaBlock{
+			3: {Transactions: []*pctypes.PlasmaTx{{Slot: 5, Denomination: bigUInt(1), NewOwner: ethAddress(owner)}}},
+		},
+		coins: map[uint64]*pctypes.PlasmaCashCoin{
+			5: {Slot: 5, State: pctypes.PlasmaCashCoinState_DEPOSITED},
+		},
+		latestBlocks: map[uint64]int64{5: 3},
+	}
+}
+
+func newTransfer(to *ecdsa.PrivateKey) *pctypes.PlasmaTx {
+	return &pctypes.PlasmaTx{Slot: 5, PreviousBlock: bigUInt(3), Denomination: bigUInt(1), NewOwner: ethAddress(to)}
+}
+
+func (s *TxValidationTestSuite) TestValidTransfer(c *C) {
+	owner, _ := crypto.GenerateKey()
+	recipient, _ := crypto.GenerateKey()
+	tx := newTransfer(recipient)
+	signTx(c, tx, owner)
+
+	c.Assert(Validate(newTestState(owner), tx, AllChecks), IsNil)
+}
+
+func (s *TxValidationTestSuite) TestTransferNotSignedByOwner(c *C) {
+	owner, _ := crypto.GenerateKey()
+	thief, _ := crypto.GenerateKey()
+	tx := newTransfer(thief)
+	signTx(c, tx, thief)
+
+	state := newTestState(owner)
+	c.Assert(errors.Cause(Validate(state, tx, AllChecks)), Equals, ErrNotOwner)
+	// Only the owner check cares who signed the tx
+	c.Assert(Validate(state, tx, CheckSignature|CheckCoinState), IsNil)
+}
+
+func (s *TxValidationTestSuite) TestMalformedSignature(c *C) {
+	owner, _ := crypto.GenerateKey()
+	tx := newTransfer(owner)
+	tx.Signature = make([]byte, 65)
+
+	state := newTestState(owner)
+	c.Assert(errors.Cause(Validate(state, tx, CheckSignature)), Equals, ErrInvalidSignature)
+	c.Assert(Validate(state, tx, CheckCoinState), IsNil)
+}
+
+func (s *TxValidationTestSuite) TestInvalidSignatureV(c *C) {
+	owner, _ := crypto.GenerateKey()
+	tx := newTransfer(owner)
+	signTx(c, tx, owner)
+
+	// The RootChain contract passes V to ecrecover as is, which only accepts 27 or 28
+	state := newTestState(owner)
+	tx.Signature[65] -= 27
+	c.Assert(errors.Cause(Validate(state, tx, CheckSignature)), Equals, ErrInvalidSignature)
+	tx.Signature[65] += 27 + 2
+	c.Assert(errors.Cause(Validate(state, tx, CheckSignature)), Equals, ErrInvalidSignature)
+	tx.Signature[65] -= 2
+	c.Assert(Validate(state, tx, AllChecks), IsNil)
+}
+
+func (s *TxValidationTestSuite) TestUnknownPrevBlock(c *C) {
+	owner, _ := crypto.GenerateKey()
+	tx := newTransfer(owner)
+	tx.PreviousBlock = bigUInt(1000)
+	signTx(c, tx, owner)
+
+	state := newTestState(owner)
+	state.blocks[1000] = &pctypes.PlasmaBlock{}
+	c.Assert(errors.Cause(Validate(state, tx, CheckOwner)), Equals, ErrInvalidPrevBlock)
+}
+
+func (s *TxValidationTestSuite) TestDoubleSpend(c *C) {
+	owner, _ := crypto.GenerateKey()
+	recipient, _ := crypto.GenerateKey()
+	tx := newTransfer(recipient)
+	signTx(c, tx, owner)
+
+	// The owner already transferred the coin to someone else in block 1000, but still owns the
+	// coin as of the deposit block
+	state := newTestState(owner)
+	state.blocks[1000] = &pctypes.PlasmaBlock{Transactions: []*pctypes.PlasmaTx{
+		{Slot: 5, PreviousBlock: bigUInt(3), Denomination: bigUInt(1), NewOwner: ethAddress(recipient)},
+	}}
+	state.latestBlocks[5] = 1000
+	c.Assert(errors.Cause(Validate(state, tx, AllChecks)), Equals, ErrStalePrevBlock)
+	c.Assert(Validate(state, tx, AllChecks&^CheckLatestBlock), IsNil)
+}
+
+func (s *TxValidationTestSuite) TestExitingCoin(c *C) {
+	owner, _ := crypto.GenerateKey()
+	tx := newTransfer(owner)
+	signTx(c, tx, owner)
+
+	state := newTestState(owner)
+	state.coins[5].State = pctypes.PlasmaCashCoinState_EXITING
+	c.Assert(errors.Cause(Validate(state, tx, AllChecks)), Equals, ErrCoinNotDeposited)
+	c.Assert(Validate(state, tx, CheckSignature|CheckOwner), IsNil)
+}
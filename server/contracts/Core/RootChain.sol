@@ -132,7 +132,7 @@ contract RootChain is ERC721Receiver, ERC20Receiver {
     using ECVerify for bytes32;
     using ChallengeLib for ChallengeLib.Challenge[];
 
-    uint256 constant BOND_AMOUNT = 0.1 ether;
+    uint256 constant public BOND_AMOUNT = 0.1 ether;
     // The contract does not accept more than that amount
     uint256 constant MAX_VALUE = 10 ether;
     // An exit can be finalized after it has matured,
@@ -839,7 +839,8 @@ contract RootChain is ERC721Receiver, ERC20Receiver {
     // Approve and Deposit function for 2-step deposits without having to approve the token by the validators
     // Requires first to have called `approve` on the specified ERC721 contract
     function depositERC721(uint256 uid, address contractAddress) external {
-        ERC721(contractAddress).safeTransferFrom(msg.sender, address(this), uid);
+        // safeTransferFrom would call onERC721Received, which deposits the token a second time
+        ERC721(contractAddress).transferFrom(msg.sender, address(this), uid);
         deposit(msg.sender, contractAddress, uid, 1, Mode.ERC721);
     }
 
@@ -0,0 +1,38 @@
+// Generates the sparse merkle tree proof vectors used to test the Go verifier in loom_test/src/smt.
+// Usage (from the server dir): truffle exec scripts/gen_smt_vectors.js
+const fs = require('fs');
+const path = require('path');
+const utils = require('web3-utils');
+const SparseMerkleTree = require('../test/SparseMerkleTree.js');
+
+const outFile = path.join(__dirname, '../../loom_test/src/smt/testdata/vectors.json');
+
+// Each entry lists the slots of the leaves in the tree, and the slots proofs should be created for
+// that aren't in the tree (exclusion proofs).
+const trees = [
+    { slots: ['2'], excluded: ['3', '1000'] },
+    {
+        slots: ['2', '3', '5', '14414645988802088183'],
+        excluded: ['4', '6', '14414645988802088182', '18446744073709551615']
+    },
+    { slots: ['0', '1', '18446744073709551615'], excluded: ['2', '9223372036854775808'] },
+];
+
+module.exports = function(callback) {
+    const vectors = trees.map((t, n) => {
+        const leaves = {};
+        for (const slot of t.slots) {
+            leaves[slot] = utils.soliditySha3(`tx-${n}-${slot}`);
+        }
+        const tree = new SparseMerkleTree(64, leaves);
+        const proofs = t.slots.map(slot => ({
+            slot, leaf: leaves[slot], proof: tree.createMerkleProof(slot)
+        })).concat(t.excluded.map(slot => ({
+            slot, leaf: tree.defaultNodes[0], proof: tree.createMerkleProof(slot)
+        })));
+        return { leaves, root: tree.root, proofs };
+    });
+    fs.writeFileSync(outFile, JSON.stringify(vectors, null, 2) + '\n');
+    console.log(`Wrote ${outFile}`);
+    callback();
+};
@@ -65,6 +65,20 @@ contract("Plasma Cash - All In One", async function(accounts) {
         await plasma.sendTransaction({from: alice, value: ethers[2], gas: 220000 })
     })
 
+    it('Deposits a validator approved ERC721 token once via depositERC721', async function() {
+        vmc = await ValidatorManagerContract.new({from: authority});
+        plasma = await RootChain.new(vmc.address, {from: authority});
+        erc721 = await CryptoCards.new(plasma.address, {from: authority});
+        await vmc.toggleToken(erc721.address, {from: authority});
+        await erc721.register({from: alice});
+
+        await erc721.approve(plasma.address, coins[0], {from: alice});
+        await plasma.depositERC721(coins[0], erc721.address, {from: alice});
+
+        assert.equal((await plasma.numCoins.call()).toNumber(), 1);
+        assert.equal(await erc721.balanceOf.call(plasma.address), 1);
+    })
+
     describe('Multideposit tests', function() {
         beforeEach(async function() {
             vmc = await ValidatorManagerContract.new({from: authority});